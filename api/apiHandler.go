@@ -99,6 +99,26 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		return nil, err
 	}
 
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	usernameGroup, err := groups.NewUsernameGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsGroup, err := groups.NewEventsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooksGroup, err := groups.NewWebhooksGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]data.GroupHandler{
 		"/actions":     actionsGroup,
 		"/address":     accountsGroup,
@@ -114,6 +134,10 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		"/vm-values":   vmValuesGroup,
 		"/proof":       proofGroup,
 		"/about":       aboutGroup,
+		"/utils":       utilsGroup,
+		"/username":    usernameGroup,
+		"/events":      eventsGroup,
+		"/webhooks":    webhooksGroup,
 	}, nil
 }
 
@@ -20,6 +20,12 @@ var ErrInvalidAddressesArray = errors.New("invalid addresses array")
 // ErrCannotGetAddresses signals an error when trying to fetch a bulk of accounts
 var ErrCannotGetAddresses = errors.New("error while fetching a bulk of accounts")
 
+// ErrInvalidESDTBalancesRequest signals that an invalid bulk ESDT balances request has been provided
+var ErrInvalidESDTBalancesRequest = errors.New("invalid bulk ESDT balances request")
+
+// ErrCannotGetESDTBalances signals an error while fetching the ESDT balances of a bulk of accounts
+var ErrCannotGetESDTBalances = errors.New("error while fetching the ESDT balances of a bulk of accounts")
+
 // ErrComputeShardForAddress signals an error in computing the shard ID for a given address
 var ErrComputeShardForAddress = errors.New("compute shard ID for address error")
 
@@ -74,6 +80,18 @@ var ErrGetCodeHash = errors.New("cannot get code hash")
 // ErrValidationQueryParameterWithResult signals that an invalid query parameter has been provided
 var ErrValidationQueryParameterWithResult = errors.New("invalid query parameter withResults")
 
+// ErrValidationQueryParameterWithFeeBreakdown signals that an invalid query parameter has been provided
+var ErrValidationQueryParameterWithFeeBreakdown = errors.New("invalid query parameter withFeeBreakdown")
+
+// ErrValidationQueryParameterWithDecodedEvents signals that an invalid query parameter has been provided
+var ErrValidationQueryParameterWithDecodedEvents = errors.New("invalid query parameter withDecodedEvents")
+
+// ErrValidationQueryParameterDryRun signals that an invalid query parameter has been provided
+var ErrValidationQueryParameterDryRun = errors.New("invalid query parameter dryRun")
+
+// ErrValidationQueryParameterCount signals that an invalid query parameter has been provided
+var ErrValidationQueryParameterCount = errors.New("invalid query parameter count")
+
 // ErrValidatorQueryParameterCheckSignature signals that an invalid query parameter has been provided
 var ErrValidatorQueryParameterCheckSignature = errors.New("invalid query parameter checkSignature")
 
@@ -110,6 +128,15 @@ var ErrTransactionHashMissing = errors.New("transaction hash missing")
 // ErrFaucetNotEnabled signals that the faucet mechanism is not enabled
 var ErrFaucetNotEnabled = errors.New("faucet not enabled")
 
+// ErrSearchEvents signals an error while searching the events
+var ErrSearchEvents = errors.New("cannot search events")
+
+// ErrCannotParseTimestamp signals that the timestamp cannot be parsed
+var ErrCannotParseTimestamp = errors.New("cannot parse timestamp")
+
+// ErrInvalidTimestampParam signals that an invalid timestamp parameter has been provided
+var ErrInvalidTimestampParam = errors.New("invalid timestamp parameter")
+
 // ErrInvalidBlockNonceParam signals that an invalid block's nonce parameter has been provided
 var ErrInvalidBlockNonceParam = errors.New("invalid block nonce parameter")
 
@@ -159,3 +186,54 @@ type ErrInvalidTxFields struct {
 func (eitx *ErrInvalidTxFields) Error() string {
 	return fmt.Sprintf("%s : %s", eitx.Message, eitx.Reason)
 }
+
+// ErrReserveNonces signals an error while reserving nonces for an address
+var ErrReserveNonces = errors.New("cannot reserve nonces")
+
+// ErrSyncNonceReservations signals an error while syncing the nonce reservations of an address
+var ErrSyncNonceReservations = errors.New("cannot sync nonce reservations")
+
+// ErrGetAccountPortfolio signals an error while fetching the portfolio of an account
+var ErrGetAccountPortfolio = errors.New("cannot get account portfolio")
+
+// ErrGetBalanceHistory signals an error while fetching the balance history of an account
+var ErrGetBalanceHistory = errors.New("cannot get balance history")
+
+// ErrGetAccountDiff signals an error while computing the state diff of an account
+var ErrGetAccountDiff = errors.New("cannot get account diff")
+
+// ErrStreamKeys signals an error while streaming the key-value pairs of an account
+var ErrStreamKeys = errors.New("cannot stream keys")
+
+// ErrTooManyAddresses signals that too many addresses were provided in a bulk request
+var ErrTooManyAddresses = errors.New("too many addresses")
+
+// ErrComputeContractAddress signals an error while computing the address of a smart contract
+var ErrComputeContractAddress = errors.New("cannot compute contract address")
+
+// ErrGetUsername signals an error while fetching the username of an address
+var ErrGetUsername = errors.New("cannot get username")
+
+// ErrResolveUsername signals an error while resolving a username
+var ErrResolveUsername = errors.New("cannot resolve username")
+
+// ErrEmptyUsername signals that an empty username was provided
+var ErrEmptyUsername = errors.New("username is empty")
+
+// ErrStreamHyperblocks signals an error while streaming a range of hyperblocks
+var ErrStreamHyperblocks = errors.New("cannot stream hyperblocks")
+
+// ErrStreamBlocks signals an error while streaming a range of blocks
+var ErrStreamBlocks = errors.New("cannot stream blocks")
+
+// ErrRegisterWebhook signals an error while registering a webhook
+var ErrRegisterWebhook = errors.New("cannot register webhook")
+
+// ErrRemoveWebhook signals an error while removing a webhook
+var ErrRemoveWebhook = errors.New("cannot remove webhook")
+
+// ErrGetWebhookDeliveries signals an error while fetching the delivery log of a webhook
+var ErrGetWebhookDeliveries = errors.New("cannot get webhook deliveries")
+
+// ErrFollowHyperblocks signals an error while streaming the newly synchronized hyperblocks
+var ErrFollowHyperblocks = errors.New("cannot follow hyperblocks")
@@ -3,10 +3,12 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -35,6 +37,7 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet},
 		{Path: "/:address/code-hash", Handler: ag.getCodeHash, Method: http.MethodGet},
 		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet},
+		{Path: "/:address/keys/stream", Handler: ag.streamKeys, Method: http.MethodGet},
 		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet},
 		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet},
 		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet},
@@ -44,8 +47,14 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet},
 		{Path: "/:address/guardian-data", Handler: ag.getGuardianData, Method: http.MethodGet},
 		{Path: "/:address/is-data-trie-migrated", Handler: ag.isDataTrieMigrated, Method: http.MethodGet},
+		{Path: "/:address/portfolio", Handler: ag.getAccountPortfolio, Method: http.MethodGet},
+		{Path: "/:address/balance-history", Handler: ag.getBalanceHistory, Method: http.MethodGet},
+		{Path: "/:address/diff", Handler: ag.getAccountDiff, Method: http.MethodGet},
 		{Path: "/iterate-keys", Handler: ag.iterateKeys, Method: http.MethodPost},
 		{Path: "/bulk", Handler: ag.getAccounts, Method: http.MethodPost},
+		{Path: "/bulk/esdt", Handler: ag.getESDTBalancesForAccounts, Method: http.MethodPost},
+		{Path: "/:address/nonce/reserve", Handler: ag.reserveNonces, Method: http.MethodPost},
+		{Path: "/:address/nonce/sync", Handler: ag.syncNonceReservations, Method: http.MethodPost},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -88,11 +97,34 @@ func (group *accountsGroup) getBalance(c *gin.Context) {
 
 // getUsername returns the username for the address parameter
 func (group *accountsGroup) getUsername(c *gin.Context) {
+	verify, err := parseBoolUrlParam(c, common.UrlParameterVerify)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if verify {
+		group.getVerifiedUsername(c)
+		return
+	}
+
 	group.respondWithAccount(c, func(model *data.AccountModel) gin.H {
 		return gin.H{"username": model.Account.Username, "blockInfo": model.BlockInfo}
 	})
 }
 
+// getVerifiedUsername returns the username of the address parameter, along with whether the username resolves back
+// to the same address through the DNS contracts
+func (group *accountsGroup) getVerifiedUsername(c *gin.Context) {
+	addr := c.Param("address")
+	addressUsername, err := group.facade.GetUsernameForAddress(addr)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetUsername, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"username": addressUsername.Username, "isVerified": addressUsername.IsVerified}, "", data.ReturnCodeSuccess)
+}
+
 // getNonce returns the nonce for the address parameter
 func (group *accountsGroup) getNonce(c *gin.Context) {
 	group.respondWithAccount(c, func(model *data.AccountModel) gin.H {
@@ -147,6 +179,34 @@ func (group *accountsGroup) getAccounts(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
+// getESDTBalancesForAccounts will handle the request for the ESDT balances of a bulk of addresses
+func (group *accountsGroup) getESDTBalancesForAccounts(c *gin.Context) {
+	request := data.AccountsESDTBalancesRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidESDTBalancesRequest, err)
+		return
+	}
+	if len(request.Addresses) == 0 {
+		shared.RespondWithBadRequest(c, errors.ErrInvalidAddressesArray.Error())
+		return
+	}
+
+	options, err := parseAccountQueryOptions(c, request.Addresses[0])
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidFields, err)
+		return
+	}
+
+	response, err := group.facade.GetESDTBalancesForAccounts(request.Addresses, request.Tokens, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCannotGetESDTBalances, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
 // getKeyValuePairs returns the key-value pairs for the address parameter
 func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 	addr := c.Param("address")
@@ -161,6 +221,22 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
+	decode, err := parseBoolUrlParam(c, common.UrlParameterDecode)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+	if decode {
+		decodedPairs, errDecode := group.facade.GetDecodedKeyValuePairs(addr, options)
+		if errDecode != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, errDecode)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, gin.H{"pairs": decodedPairs.Pairs, "blockInfo": decodedPairs.BlockInfo}, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	keyValuePairs, err := group.facade.GetKeyValuePairs(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
@@ -190,6 +266,22 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
+	decode, err := parseBoolUrlParam(c, common.UrlParameterDecode)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetValueForKey, err)
+		return
+	}
+	if decode {
+		decodedEntry, errDecode := group.facade.GetDecodedValueForKey(addr, key, options)
+		if errDecode != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetValueForKey, errDecode)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, gin.H{"value": decodedEntry.Value, "decoded": decodedEntry}, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	value, err := group.facade.GetValueForKey(addr, key, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetValueForKey, err)
@@ -448,3 +540,190 @@ func (group *accountsGroup) iterateKeys(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// getAccountPortfolio returns all the data of the address parameter, fetched at the same block
+func (group *accountsGroup) getAccountPortfolio(c *gin.Context) {
+	address := c.Param("address")
+	options, err := parseAccountQueryOptions(c, address)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	portfolio, err := group.facade.GetAccountPortfolio(address, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountPortfolio, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"portfolio": portfolio}, "", data.ReturnCodeSuccess)
+}
+
+// getBalanceHistory returns the balance, nonce and the requested ESDT balances of the address parameter, sampled over
+// a block nonce or an epoch range
+func (group *accountsGroup) getBalanceHistory(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetBalanceHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseBalanceHistoryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	history, err := group.facade.GetBalanceHistory(address, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetBalanceHistory, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"history": history}, "", data.ReturnCodeSuccess)
+}
+
+// getAccountDiff returns what changed in the state of the address parameter between two blocks
+func (group *accountsGroup) getAccountDiff(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountDiff, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseAccountDiffOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	diff, err := group.facade.GetAccountDiff(address, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountDiff, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"diff": diff}, "", data.ReturnCodeSuccess)
+}
+
+// streamKeys streams all the key-value pairs of the address parameter as NDJSON. After each page of pairs, a checkpoint
+// line holds the token from which the stream can be resumed. A complete stream ends with a done line, while an
+// interrupted one ends with an error line
+func (group *accountsGroup) streamKeys(c *gin.Context) {
+	address := c.Param("address")
+	options, err := parseAccountQueryOptions(c, address)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	numKeys, err := parseUint32UrlParam(c, common.UrlParameterNumKeys)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if !numKeys.HasValue {
+		numKeys.Value = common.DefaultKeysStreamPageSize
+	}
+	if numKeys.Value == 0 || numKeys.Value > common.MaxKeysStreamPageSize {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: %s must be between 1 and %d", errors.ErrBadUrlParams.Error(), common.UrlParameterNumKeys, common.MaxKeysStreamPageSize))
+		return
+	}
+
+	var resumeState *data.KeysStreamResumeState
+	resumeToken := parseStringUrlParam(c, common.UrlParameterResumeToken)
+	if len(resumeToken) > 0 {
+		resumeState, err = data.NewKeysStreamResumeStateFromToken(resumeToken)
+		if err != nil {
+			shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+			return
+		}
+	}
+
+	numStreamedKeys := uint64(0)
+	writer := shared.NewNDJSONStreamWriter(c)
+	err = group.facade.StreamKeys(address, uint(numKeys.Value), resumeState, options, func(page *data.AccountKeysPage) error {
+		keys := make([]string, 0, len(page.Pairs))
+		for key := range page.Pairs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			errWrite := writer.WriteLine(data.AccountKeyValuePair{Key: key, Value: page.Pairs[key]})
+			if errWrite != nil {
+				return errWrite
+			}
+		}
+		numStreamedKeys += uint64(len(keys))
+
+		var line interface{} = data.AccountKeysStreamEnd{Done: true, NumKeys: numStreamedKeys}
+		if len(page.ResumeToken) > 0 {
+			line = data.AccountKeysStreamCheckpoint{ResumeToken: page.ResumeToken, NumKeys: numStreamedKeys, BlockInfo: page.BlockInfo}
+		}
+		errWrite := writer.WriteLine(line)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		writer.Flush()
+		return nil
+	})
+	if err == nil {
+		return
+	}
+	if !writer.IsStarted() {
+		shared.RespondWithInternalError(c, errors.ErrStreamKeys, err)
+		return
+	}
+
+	_ = writer.WriteLine(data.StreamError{Error: fmt.Sprintf("%s: %s", errors.ErrStreamKeys.Error(), err.Error())})
+	writer.Flush()
+}
+
+// reserveNonces hands out a range of consecutive nonces for the address parameter
+func (group *accountsGroup) reserveNonces(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrReserveNonces, errors.ErrEmptyAddress)
+		return
+	}
+
+	count, err := parseUint64UrlParam(c, common.UrlParameterCount)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidationQueryParameterCount, err)
+		return
+	}
+	if !count.HasValue {
+		count.Value = 1
+	}
+	if count.Value == 0 || count.Value > common.MaxNonceReservationCount {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: must be between 1 and %d", errors.ErrValidationQueryParameterCount.Error(), common.MaxNonceReservationCount))
+		return
+	}
+
+	reservation, err := group.facade.ReserveNonces(address, count.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrReserveNonces, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"reservation": reservation}, "", data.ReturnCodeSuccess)
+}
+
+// syncNonceReservations discards the nonces reserved for the address parameter and re-aligns them with the chain state
+func (group *accountsGroup) syncNonceReservations(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrSyncNonceReservations, errors.ErrEmptyAddress)
+		return
+	}
+
+	syncResult, err := group.facade.SyncNonceReservations(address)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrSyncNonceReservations, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"sync": syncResult}, "", data.ReturnCodeSuccess)
+}
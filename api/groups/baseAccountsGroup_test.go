@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
@@ -407,6 +408,87 @@ func TestGetUsername_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, usernameResponse.Error)
 }
 
+func TestGetUsername_Verify(t *testing.T) {
+	t.Parallel()
+
+	type verifiedUsernameResponse struct {
+		Data struct {
+			Username   string `json:"username"`
+			IsVerified bool   `json:"isVerified"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+
+	t.Run("invalid verify param should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/username?verify=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := verifiedUsernameResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetUsernameForAddressCalled: func(address string) (*data.AddressUsername, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/username?verify=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := verifiedUsernameResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return the verified username", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, nil
+			},
+			GetUsernameForAddressCalled: func(address string) (*data.AddressUsername, error) {
+				assert.Equal(t, "test", address)
+				return &data.AddressUsername{Address: address, Username: "alice.elrond", IsVerified: true}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/username?verify=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := verifiedUsernameResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "alice.elrond", response.Data.Username)
+		assert.True(t, response.Data.IsVerified)
+	})
+}
+
 //------- GetNonce
 
 func TestGetNonce_ReturnsSuccessfully(t *testing.T) {
@@ -966,6 +1048,155 @@ func TestGetKeyValuePairs_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, actualResponse.Error)
 }
 
+func TestGetKeyValuePairs_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid decode param should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys?decode=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetKeyValuePairs.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetDecodedKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys?decode=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return the decoded pairs", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsHandler: func(_ string, _ common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, nil
+			},
+			GetDecodedKeyValuePairsCalled: func(address string, _ common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error) {
+				assert.Equal(t, "test", address)
+				return &data.DecodedKeyValuePairs{
+					Pairs: map[string]*data.DecodedStorageEntry{
+						"6b6579": {Key: "6b6579", Value: "76616c7565", Type: data.StorageEntryTypeUnknown, KeyText: "key", ValueText: "value"},
+					},
+					BlockInfo: data.BlockInfo{Nonce: 10},
+				}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/keys?decode=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type decodedPairsResponse struct {
+			Data struct {
+				Pairs     map[string]*data.DecodedStorageEntry `json:"pairs"`
+				BlockInfo data.BlockInfo                       `json:"blockInfo"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := &decodedPairsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, uint64(10), response.Data.BlockInfo.Nonce)
+		require.Len(t, response.Data.Pairs, 1)
+		assert.Equal(t, "key", response.Data.Pairs["6b6579"].KeyText)
+		assert.Equal(t, "value", response.Data.Pairs["6b6579"].ValueText)
+	})
+}
+
+func TestGetValueForKey_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetDecodedValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (*data.DecodedStorageEntry, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/key/6b6579?decode=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return the decoded value", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDecodedValueForKeyCalled: func(address string, key string, _ common.AccountQueryOptions) (*data.DecodedStorageEntry, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, "6b6579", key)
+				return &data.DecodedStorageEntry{Key: key, Value: "010a", Type: data.StorageEntryTypeNFTLastNonce, TokenIdentifier: "NFT-abcdef", Nonce: 266}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/key/6b6579?decode=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type decodedValueResponse struct {
+			Data struct {
+				Value   string                    `json:"value"`
+				Decoded *data.DecodedStorageEntry `json:"decoded"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := &decodedValueResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "010a", response.Data.Value)
+		assert.Equal(t, data.StorageEntryTypeNFTLastNonce, response.Data.Decoded.Type)
+		assert.Equal(t, uint64(266), response.Data.Decoded.Nonce)
+	})
+}
+
 // ---- get code hash
 
 func TestGetCodeHash_FailWhenFacadeErrors(t *testing.T) {
@@ -1172,3 +1403,662 @@ func TestAccountsGroup_IterateKeys(t *testing.T) {
 		assert.Equal(t, 2, len(respIterState))
 	})
 }
+
+func TestAccountsGroup_ReserveNonces(t *testing.T) {
+	t.Parallel()
+
+	type reserveNoncesResponse struct {
+		GeneralResponse
+		Data struct {
+			Reservation *data.NonceReservation `json:"reservation"`
+		} `json:"data"`
+	}
+
+	t.Run("invalid count should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		for _, count := range []string{"not-a-number", "0", fmt.Sprintf("%d", common.MaxNonceReservationCount+1)} {
+			req, _ := http.NewRequest("POST", "/address/erd1alice/nonce/reserve?count="+count, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationQueryParameterCount.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ReserveNoncesCalled: func(address string, count uint64) (*data.NonceReservation, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/erd1alice/nonce/reserve", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should default to one nonce", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReserveNoncesCalled: func(address string, count uint64) (*data.NonceReservation, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, uint64(1), count)
+				return &data.NonceReservation{}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/erd1alice/nonce/reserve", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReservation := &data.NonceReservation{
+			Address:      "erd1alice",
+			FirstNonce:   7,
+			LastNonce:    11,
+			Count:        5,
+			AccountNonce: 7,
+		}
+		facade := &mock.FacadeStub{
+			ReserveNoncesCalled: func(address string, count uint64) (*data.NonceReservation, error) {
+				assert.Equal(t, uint64(5), count)
+				return providedReservation, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/erd1alice/nonce/reserve?count=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := reserveNoncesResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedReservation, response.Data.Reservation)
+	})
+}
+
+func TestAccountsGroup_SyncNonceReservations(t *testing.T) {
+	t.Parallel()
+
+	type syncNonceReservationsResponse struct {
+		GeneralResponse
+		Data struct {
+			Sync *data.NonceReservationSync `json:"sync"`
+		} `json:"data"`
+	}
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			SyncNonceReservationsCalled: func(address string) (*data.NonceReservationSync, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/erd1alice/nonce/sync", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedSync := &data.NonceReservationSync{
+			Address:       "erd1alice",
+			NextNonce:     16,
+			AccountNonce:  10,
+			LastPoolNonce: 15,
+			NonceGaps:     []data.NonceGap{{From: 12, To: 13}},
+		}
+		facade := &mock.FacadeStub{
+			SyncNonceReservationsCalled: func(address string) (*data.NonceReservationSync, error) {
+				return providedSync, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/erd1alice/nonce/sync", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := syncNonceReservationsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedSync, response.Data.Sync)
+	})
+}
+
+func TestAccountsGroup_GetAccountPortfolio(t *testing.T) {
+	t.Parallel()
+
+	type portfolioResponse struct {
+		GeneralResponse
+		Data struct {
+			Portfolio *data.AccountPortfolio `json:"portfolio"`
+		} `json:"data"`
+	}
+
+	t.Run("invalid options should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/portfolio?onFinalBlock=not-a-bool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetAccountPortfolioCalled: func(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/portfolio", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedPortfolio := &data.AccountPortfolio{
+			Account:  data.Account{Address: "erd1alice", Nonce: 7},
+			Username: "alice.elrond",
+			FungibleTokens: []*data.AccountESDTToken{
+				{TokenIdentifier: "MEX-abcdef", Balance: "100"},
+			},
+			NonFungibleTokens: []*data.AccountESDTToken{},
+			Roles:             map[string][]string{},
+			BlockInfo:         data.BlockInfo{Nonce: 37},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountPortfolioCalled: func(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.True(t, options.OnFinalBlock)
+				return providedPortfolio, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/portfolio?onFinalBlock=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := portfolioResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedPortfolio, response.Data.Portfolio)
+	})
+}
+
+func TestAccountsGroup_GetESDTBalancesForAccounts(t *testing.T) {
+	t.Parallel()
+
+	type esdtBalancesResponse struct {
+		GeneralResponse
+		Data data.AccountsESDTBalances `json:"data"`
+	}
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/bulk/esdt", bytes.NewBuffer([]byte(`["not an object"]`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidESDTBalancesRequest.Error()))
+	})
+	t.Run("no addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/bulk/esdt", bytes.NewBuffer([]byte(`{"tokens": ["MEX-abcdef"]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidAddressesArray.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetESDTBalancesForAccountsCalled: func(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("POST", "/address/bulk/esdt", bytes.NewBuffer([]byte(`{"addresses": ["erd1alice"]}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedBalances := map[string]map[string]string{
+			"erd1alice": {"MEX-abcdef": "10"},
+			"erd1bob":   {"MEX-abcdef": "0"},
+		}
+		facade := &mock.FacadeStub{
+			GetESDTBalancesForAccountsCalled: func(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error) {
+				assert.Equal(t, []string{"erd1alice", "erd1bob"}, addresses)
+				assert.Equal(t, []string{"MEX-abcdef"}, tokens)
+				return &data.AccountsESDTBalances{Balances: providedBalances}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		reqBody := `{"addresses": ["erd1alice", "erd1bob"], "tokens": ["MEX-abcdef"]}`
+		req, _ := http.NewRequest("POST", "/address/bulk/esdt", bytes.NewBuffer([]byte(reqBody)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtBalancesResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedBalances, response.Data.Balances)
+	})
+}
+
+func TestAccountsGroup_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	type balanceHistoryResponse struct {
+		GeneralResponse
+		Data struct {
+			History *data.AccountBalanceHistory `json:"history"`
+		} `json:"data"`
+	}
+
+	t.Run("invalid ranges should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		invalidQueries := []string{
+			"",
+			"fromNonce=not-a-number&toNonce=10",
+			"fromNonce=10",
+			"fromNonce=20&toNonce=10",
+			"fromNonce=10&toNonce=20&step=0",
+			"fromNonce=10&toNonce=20&fromEpoch=1&toEpoch=2",
+			"fromEpoch=1&toEpoch=100000",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/address/erd1alice/balance-history?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetBalanceHistoryCalled: func(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/balance-history?fromEpoch=1&toEpoch=3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedHistory := &data.AccountBalanceHistory{
+			Address: "erd1alice",
+			Points: []*data.BalanceHistoryPoint{
+				{BlockNonce: 100, Balance: "10", Nonce: 1, ESDTBalances: map[string]string{"MEX-abcdef": "5"}},
+				{BlockNonce: 150, Balance: "20", Nonce: 2, ESDTBalances: map[string]string{"MEX-abcdef": "0"}},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetBalanceHistoryCalled: func(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, core.OptionalUint64{Value: 100, HasValue: true}, options.FromNonce)
+				assert.Equal(t, core.OptionalUint64{Value: 199, HasValue: true}, options.ToNonce)
+				assert.Equal(t, uint64(50), options.Step)
+				assert.Equal(t, []string{"MEX-abcdef"}, options.Tokens)
+				return providedHistory, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/balance-history?fromNonce=100&toNonce=199&step=50&tokens=MEX-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := balanceHistoryResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedHistory, response.Data.History)
+	})
+}
+
+func TestAccountsGroup_GetAccountDiff(t *testing.T) {
+	t.Parallel()
+
+	type accountDiffResponse struct {
+		GeneralResponse
+		Data struct {
+			Diff *data.AccountDiff `json:"diff"`
+		} `json:"data"`
+	}
+
+	t.Run("invalid ranges should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		invalidQueries := []string{
+			"",
+			"fromBlockNonce=10",
+			"fromBlockNonce=10&toBlockNonce=10",
+			"fromBlockNonce=a&toBlockNonce=10",
+			"fromBlockNonce=1&toBlockNonce=10&withKeys=maybe",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/address/erd1alice/diff?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetAccountDiffCalled: func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/diff?fromBlockNonce=1&toBlockNonce=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedDiff := &data.AccountDiff{
+			Address:      "erd1alice",
+			BalanceDelta: "-5",
+			NonceDelta:   2,
+			TokensAdded:  []*data.ESDTTokenDiff{{TokenIdentifier: "MEX-abcdef", BalanceBefore: "0", BalanceAfter: "1", BalanceDelta: "1"}},
+		}
+		facade := &mock.FacadeStub{
+			GetAccountDiffCalled: func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, common.AccountDiffOptions{FromBlockNonce: 100, ToBlockNonce: 200, WithKeys: true}, options)
+				return providedDiff, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/diff?fromBlockNonce=100&toBlockNonce=200&withKeys=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedDiff, response.Data.Diff)
+	})
+}
+
+func TestAccountsGroup_StreamKeys(t *testing.T) {
+	t.Parallel()
+
+	readLines := func(body *bytes.Buffer) []map[string]interface{} {
+		lines := make([]map[string]interface{}, 0)
+		decoder := json.NewDecoder(body)
+		for decoder.More() {
+			line := make(map[string]interface{})
+			_ = decoder.Decode(&line)
+			lines = append(lines, line)
+		}
+
+		return lines
+	}
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		invalidQueries := []string{
+			"numKeys=0",
+			"numKeys=100000",
+			"numKeys=abc",
+			"resumeToken=invalid!",
+			"onFinalBlock=maybe",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/address/erd1alice/keys/stream?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("error before the first page should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StreamKeysCalled: func(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error {
+				return expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/keys/stream", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should stream the pages", func(t *testing.T) {
+		t.Parallel()
+
+		providedResumeState := &data.KeysStreamResumeState{RootHash: "abcd", IteratorState: [][]byte{[]byte("state")}}
+		facade := &mock.FacadeStub{
+			StreamKeysCalled: func(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, uint(2), numKeysPerPage)
+				assert.Equal(t, providedResumeState, resumeState)
+
+				_ = handler(&data.AccountKeysPage{Pairs: map[string]string{"bb": "02", "aa": "01"}, ResumeToken: "token"})
+				return handler(&data.AccountKeysPage{Pairs: map[string]string{"cc": "03"}})
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/keys/stream?numKeys=2&resumeToken="+providedResumeState.ToToken(), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		lines := readLines(resp.Body)
+		require.Len(t, lines, 5)
+		assert.Equal(t, map[string]interface{}{"key": "aa", "value": "01"}, lines[0])
+		assert.Equal(t, map[string]interface{}{"key": "bb", "value": "02"}, lines[1])
+		assert.Equal(t, "token", lines[2]["resumeToken"])
+		assert.Equal(t, float64(2), lines[2]["numKeys"])
+		assert.Equal(t, map[string]interface{}{"key": "cc", "value": "03"}, lines[3])
+		assert.Equal(t, map[string]interface{}{"done": true, "numKeys": float64(3)}, lines[4])
+	})
+	t.Run("error after the first page should end the stream with an error line", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamKeysCalled: func(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error {
+				_ = handler(&data.AccountKeysPage{Pairs: map[string]string{"aa": "01"}, ResumeToken: "token"})
+				return errors.New("observer went offline")
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/erd1alice/keys/stream", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		lines := readLines(resp.Body)
+		require.Len(t, lines, 3)
+		assert.True(t, strings.Contains(lines[2]["error"].(string), "observer went offline"))
+	})
+}
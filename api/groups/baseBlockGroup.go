@@ -1,11 +1,14 @@
 package groups
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -29,6 +32,8 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/:shard/by-nonce/:nonce", Handler: bg.byNonceHandler, Method: http.MethodGet},
 		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet},
+		{Path: "/:shard/by-timestamp/:timestamp", Handler: bg.byTimestampHandler, Method: http.MethodGet},
+		{Path: "/:shard/range", Handler: bg.rangeHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-nonce/:nonce", Handler: bg.alteredAccountsByNonceHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-hash/:hash", Handler: bg.alteredAccountsByHashHandler, Method: http.MethodGet},
 	}
@@ -119,6 +124,103 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
 
+// byTimestampHandler will handle the fetching and returning the latest block produced at or before a Unix timestamp
+func (group *blockGroup) byTimestampHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseShardID.Error())
+		return
+	}
+
+	timestamp, err := shared.FetchTimestampFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseTimestamp.Error())
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blockByTimestampResponse, err := group.facade.GetBlockByTimestamp(shardID, timestamp, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, blockByTimestampResponse)
+}
+
+// rangeHandler streams the blocks of a shard between two nonces as NDJSON, in nonce order. A complete stream ends with a
+// done line, while an interrupted one ends with an error line
+func (group *blockGroup) rangeHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseShardID.Error())
+		return
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	if !fromNonce.HasValue || !toNonce.HasValue || fromNonce.Value > toNonce.Value {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, ErrInvalidBlocksNonceRange)
+		return
+	}
+	if toNonce.Value-fromNonce.Value >= common.MaxBlocksRangeSize {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: at most %d blocks can be requested at once", apiErrors.ErrBadUrlParams.Error(), common.MaxBlocksRangeSize))
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	numStreamedBlocks := uint64(0)
+	writer := shared.NewNDJSONStreamWriter(c)
+	err = group.facade.StreamBlocks(shardID, fromNonce.Value, toNonce.Value, options, func(block *api.Block) error {
+		errWrite := writer.WriteLine(block)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		numStreamedBlocks++
+		writer.Flush()
+		return nil
+	})
+	respondWithBlocksStreamEnd(c, writer, numStreamedBlocks, err)
+}
+
+// respondWithBlocksStreamEnd ends a blocks stream with a done line or, if the streaming failed, with an error line. An
+// error occurring before anything was streamed is returned as a regular error response
+func respondWithBlocksStreamEnd(c *gin.Context, writer *shared.NDJSONStreamWriter, numStreamedBlocks uint64, err error) {
+	if err == nil {
+		_ = writer.WriteLine(data.BlocksStreamEnd{Done: true, NumBlocks: numStreamedBlocks})
+		writer.Flush()
+		return
+	}
+	if !writer.IsStarted() {
+		shared.RespondWithInternalError(c, apiErrors.ErrStreamBlocks, err)
+		return
+	}
+
+	_ = writer.WriteLine(data.StreamError{Error: fmt.Sprintf("%s: %s", apiErrors.ErrStreamBlocks.Error(), err.Error())})
+	writer.Flush()
+}
+
 func (group *blockGroup) alteredAccountsByNonceHandler(c *gin.Context) {
 	shardID, err := shared.FetchShardIDFromRequest(c)
 	if err != nil {
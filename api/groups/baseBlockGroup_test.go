@@ -1,9 +1,12 @@
 package groups_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -279,6 +282,92 @@ func getAlteredAccounts(t *testing.T, ws *gin.Engine, url string, expectedRespCo
 	return &apiResp
 }
 
+func TestGetBlockByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard id, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/invalid_shard_id/by-timestamp/1700000000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, apiErrors.ErrCannotParseShardID.Error(), apiResp.Error)
+	})
+	t.Run("invalid timestamp, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-timestamp/invalid_timestamp", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, apiErrors.ErrCannotParseTimestamp.Error(), apiResp.Error)
+	})
+	t.Run("could not get response from facade, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("no block before timestamp")
+		facade := &mock.FacadeStub{
+			GetBlockByTimestampCalled: func(_ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return nil, expectedError
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-timestamp/1700000000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, data.ReturnCodeInternalError, apiResp.Code)
+		require.Equal(t, expectedError.Error(), apiResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByTimestampCalled: func(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(1700000000), timestamp)
+				assert.True(t, options.WithTransactions)
+				return &data.BlockApiResponse{
+					Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: 37, Timestamp: 1699999998}},
+				}, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/1/by-timestamp/1700000000?withTxs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, uint64(37), apiResp.Data.Block.Nonce)
+	})
+}
+
 func TestGetAlteredAccountsByNonce(t *testing.T) {
 	t.Parallel()
 
@@ -470,3 +559,143 @@ func TestGetAlteredAccountsByHash(t *testing.T) {
 		require.Equal(t, expectedApiResponse, apiResp)
 	})
 }
+
+func readNDJSONLines(t *testing.T, body *bytes.Buffer) []map[string]interface{} {
+	lines := make([]map[string]interface{}, 0)
+	decoder := json.NewDecoder(body)
+	for decoder.More() {
+		line := make(map[string]interface{})
+		require.NoError(t, decoder.Decode(&line))
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func TestGetBlocksRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		invalidQueries := []string{
+			"",
+			"fromNonce=10",
+			"toNonce=10",
+			"fromNonce=abc&toNonce=10",
+			"fromNonce=10&toNonce=9",
+			"fromNonce=10&toNonce=110",
+			"fromNonce=10&toNonce=11&withTxs=maybe",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/block/0/range?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/invalid_shard/range?fromNonce=10&toNonce=11", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrCannotParseShardID.Error(), response.Error)
+	})
+	t.Run("error before the first block should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StreamBlocksCalled: func(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error {
+				return expectedErr
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/range?fromNonce=10&toNonce=11", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should stream the blocks", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamBlocksCalled: func(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(10), fromNonce)
+				assert.Equal(t, uint64(11), toNonce)
+				assert.Equal(t, common.BlockQueryOptions{WithTransactions: true, WithLogs: true}, options)
+
+				_ = handler(&api.Block{Nonce: 10, Hash: "aa"})
+				return handler(&api.Block{Nonce: 11, Hash: "bb"})
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/1/range?fromNonce=10&toNonce=11&withTxs=true&withLogs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		lines := readNDJSONLines(t, resp.Body)
+		require.Len(t, lines, 3)
+		assert.Equal(t, float64(10), lines[0]["nonce"])
+		assert.Equal(t, "aa", lines[0]["hash"])
+		assert.Equal(t, float64(11), lines[1]["nonce"])
+		assert.Equal(t, "bb", lines[1]["hash"])
+		assert.Equal(t, map[string]interface{}{"done": true, "numBlocks": float64(2)}, lines[2])
+	})
+	t.Run("error after the first block should end the stream with an error line", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamBlocksCalled: func(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error {
+				_ = handler(&api.Block{Nonce: 10})
+				return errors.New("observer went offline")
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/range?fromNonce=10&toNonce=12", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		lines := readNDJSONLines(t, resp.Body)
+		require.Len(t, lines, 2)
+		assert.True(t, strings.Contains(lines[1]["error"].(string), apiErrors.ErrStreamBlocks.Error()))
+		assert.True(t, strings.Contains(lines[1]["error"].(string), "observer went offline"))
+	})
+}
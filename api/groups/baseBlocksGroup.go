@@ -1,11 +1,13 @@
 package groups
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -26,6 +28,7 @@ func NewBlocksGroup(facadeHandler data.FacadeHandler) (*blocksGroup, error) {
 	}
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/by-round/:round", Handler: bbg.byRoundHandler, Method: http.MethodGet},
+		{Path: "/range", Handler: bbg.rangeHandler, Method: http.MethodGet},
 	}
 	bbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -53,3 +56,48 @@ func (bbp *blocksGroup) byRoundHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByRoundResponse)
 }
+
+// rangeHandler streams the blocks, from all shards, of the rounds between two rounds as NDJSON, one line per round, in
+// round order. A complete stream ends with a done line, while an interrupted one ends with an error line
+func (bbp *blocksGroup) rangeHandler(c *gin.Context) {
+	fromRound, err := parseUint64UrlParam(c, common.UrlParameterFromRound)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	toRound, err := parseUint64UrlParam(c, common.UrlParameterToRound)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	if !fromRound.HasValue || !toRound.HasValue || fromRound.Value > toRound.Value {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, ErrInvalidBlocksRoundRange)
+		return
+	}
+	if toRound.Value-fromRound.Value >= common.MaxBlocksRangeSize {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: at most %d rounds can be requested at once", apiErrors.ErrBadUrlParams.Error(), common.MaxBlocksRangeSize))
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	numStreamedBlocks := uint64(0)
+	writer := shared.NewNDJSONStreamWriter(c)
+	err = bbp.facade.StreamBlocksByRound(fromRound.Value, toRound.Value, options, func(roundBlocks *data.RoundBlocks) error {
+		errWrite := writer.WriteLine(roundBlocks)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		numStreamedBlocks += uint64(len(roundBlocks.Blocks))
+		writer.Flush()
+		return nil
+	})
+	respondWithBlocksStreamEnd(c, writer, numStreamedBlocks, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -207,3 +208,112 @@ func TestGetBlocksByRound_DifferentWithTxsQueryParams_ExpectWithTxsFlagIsSetCorr
 		require.Empty(t, apiResp.Error)
 	}
 }
+
+func TestGetBlocksByRoundRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, err := groups.NewBlocksGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(bg, blocksPath)
+
+		invalidQueries := []string{
+			"",
+			"fromRound=10",
+			"toRound=10",
+			"fromRound=abc&toRound=10",
+			"fromRound=10&toRound=9",
+			"fromRound=10&toRound=110",
+			"fromRound=10&toRound=11&withLogs=maybe",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/blocks/range?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			require.Equal(t, http.StatusBadRequest, resp.Code, query)
+			require.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("error before the first round should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StreamBlocksByRoundCalled: func(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error {
+				return expectedErr
+			},
+		}
+		bg, err := groups.NewBlocksGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(bg, blocksPath)
+
+		req, _ := http.NewRequest("GET", "/blocks/range?fromRound=10&toRound=11", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should stream the blocks of each round", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamBlocksByRoundCalled: func(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error {
+				require.Equal(t, uint64(10), fromRound)
+				require.Equal(t, uint64(11), toRound)
+				require.Equal(t, common.BlockQueryOptions{WithTransactions: true}, options)
+
+				_ = handler(&data.RoundBlocks{Round: 10, Blocks: []*api.Block{{Round: 10, Shard: 0}, {Round: 10, Shard: 1}}})
+				return handler(&data.RoundBlocks{Round: 11, Blocks: []*api.Block{{Round: 11, Shard: 0}}})
+			},
+		}
+		bg, err := groups.NewBlocksGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(bg, blocksPath)
+
+		req, _ := http.NewRequest("GET", "/blocks/range?fromRound=10&toRound=11&withTxs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		lines := readNDJSONLines(t, resp.Body)
+		require.Len(t, lines, 3)
+		require.Equal(t, float64(10), lines[0]["round"])
+		require.Len(t, lines[0]["blocks"], 2)
+		require.Equal(t, float64(11), lines[1]["round"])
+		require.Len(t, lines[1]["blocks"], 1)
+		require.Equal(t, map[string]interface{}{"done": true, "numBlocks": float64(3)}, lines[2])
+	})
+	t.Run("error after the first round should end the stream with an error line", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamBlocksByRoundCalled: func(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error {
+				_ = handler(&data.RoundBlocks{Round: 10})
+				return errors.New("observer went offline")
+			},
+		}
+		bg, err := groups.NewBlocksGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(bg, blocksPath)
+
+		req, _ := http.NewRequest("GET", "/blocks/range?fromRound=10&toRound=12", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		lines := readNDJSONLines(t, resp.Body)
+		require.Len(t, lines, 2)
+		require.True(t, strings.Contains(lines[1]["error"].(string), "observer went offline"))
+	})
+}
@@ -0,0 +1,52 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type eventsGroup struct {
+	facade EventsFacadeHandler
+	*baseGroup
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facadeHandler data.FacadeHandler) (*eventsGroup, error) {
+	facade, ok := facadeHandler.(EventsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	eg := &eventsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: eg.searchEventsHandler, Method: http.MethodGet},
+	}
+	eg.baseGroup.endpoints = baseRoutesHandlers
+
+	return eg, nil
+}
+
+// searchEventsHandler will handle the search of the events logged in a hyperblocks range
+func (group *eventsGroup) searchEventsHandler(c *gin.Context) {
+	options, err := parseEventsSearchOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	eventsSearchResponse, err := group.facade.SearchEvents(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrSearchEvents, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, eventsSearchResponse)
+}
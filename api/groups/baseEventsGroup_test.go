@@ -0,0 +1,181 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const eventsPath = "/events"
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewEventsGroup(&mock.WrongFacade{})
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestEventsGroup_SearchEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SearchEventsCalled: func(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(eventsGroup, eventsPath)
+
+		invalidRequests := []struct {
+			query         string
+			expectedError error
+		}{
+			{query: "", expectedError: groups.ErrInvalidHyperblocksRange},
+			{query: "?fromNonce=10", expectedError: groups.ErrInvalidHyperblocksRange},
+			{query: "?fromNonce=10&toNonce=9", expectedError: groups.ErrInvalidHyperblocksRange},
+			{query: "?fromNonce=10&toNonce=110", expectedError: groups.ErrInvalidHyperblocksRange},
+			{query: "?fromNonce=10&toNonce=11&topic4=abcd", expectedError: groups.ErrInvalidTopicFilter},
+			{query: "?fromNonce=10&toNonce=11&topicX=abcd", expectedError: groups.ErrInvalidTopicFilter},
+			{query: "?fromNonce=10&toNonce=11&limit=0", expectedError: groups.ErrInvalidEventsSearchLimit},
+			{query: "?fromNonce=10&toNonce=11&limit=1001", expectedError: groups.ErrInvalidEventsSearchLimit},
+			{query: "?fromNonce=10&toNonce=11&resumeToken=%25%25", expectedError: data.ErrInvalidEventsSearchResumeToken},
+			{query: "?fromNonce=10&toNonce=11&resumeToken=" + (&data.EventsSearchResumeState{Nonce: 12}).ToToken(), expectedError: data.ErrInvalidEventsSearchResumeToken},
+		}
+		for _, request := range invalidRequests {
+			req, _ := http.NewRequest("GET", eventsPath+request.query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := data.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+			require.Equal(t, http.StatusBadRequest, resp.Code, request.query)
+			require.True(t, strings.Contains(response.Error, request.expectedError.Error()), request.query)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("observers offline")
+		facade := &mock.FacadeStub{
+			SearchEventsCalled: func(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(eventsGroup, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events?fromNonce=10&toNonce=11", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.True(t, strings.Contains(response.Error, apiErrors.ErrSearchEvents.Error()))
+		require.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SearchEventsCalled: func(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+				assert.Equal(t, common.EventsSearchOptions{
+					FromNonce:  10,
+					ToNonce:    20,
+					Identifier: "ESDTTransfer",
+					Address:    "erd1contract",
+					Topics:     []string{"", "", "erd1alice"},
+					Limit:      common.DefaultEventsSearchLimit,
+				}, options)
+
+				return &data.EventsSearchApiResponse{
+					Data: data.EventsSearchApiResponsePayload{Events: []*data.FoundEvent{
+						{
+							Event:      &transaction.Events{Identifier: "ESDTTransfer", Address: "erd1contract"},
+							TxHash:     "txHash",
+							Shard:      1,
+							BlockNonce: 15,
+						},
+					}},
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(eventsGroup, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events?fromNonce=10&toNonce=20&identifier=ESDTTransfer&address=erd1contract&topic2=erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.EventsSearchApiResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, response.Data.Events, 1)
+		require.Equal(t, "txHash", response.Data.Events[0].TxHash)
+		require.Equal(t, "ESDTTransfer", response.Data.Events[0].Event.Identifier)
+	})
+	t.Run("resume token should continue the search", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SearchEventsCalled: func(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+				assert.Equal(t, common.EventsSearchOptions{
+					FromNonce:      15,
+					ToNonce:        20,
+					Topics:         []string{},
+					Limit:          10,
+					FromEventIndex: 3,
+				}, options)
+
+				return &data.EventsSearchApiResponse{
+					Data: data.EventsSearchApiResponsePayload{ResumeToken: "next"},
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(eventsGroup, eventsPath)
+
+		resumeToken := (&data.EventsSearchResumeState{Nonce: 15, EventIndex: 3}).ToToken()
+		req, _ := http.NewRequest("GET", "/events?fromNonce=10&toNonce=20&limit=10&resumeToken="+resumeToken, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.EventsSearchApiResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "next", response.Data.ResumeToken)
+	})
+}
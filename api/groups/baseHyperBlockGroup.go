@@ -2,14 +2,25 @@ package groups
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const (
+	hyperblockEventName = "hyperblock"
+	errorEventName      = "error"
+)
+
 type hyperBlockGroup struct {
 	facade HyperBlockFacadeHandler
 	*baseGroup
@@ -30,6 +41,9 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
 		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/by-timestamp/:timestamp", Handler: hbg.hyperBlockByTimestampHandler, Method: http.MethodGet},
+		{Path: "/range", Handler: hbg.hyperBlocksRangeHandler, Method: http.MethodGet},
+		{Path: "/stream", Handler: hbg.hyperBlocksStreamHandler, Method: http.MethodGet},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -51,13 +65,19 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
+	withDecodedEvents, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedEvents)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
 	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash, options)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHyperblockError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	group.respondWithHyperblock(c, blockByHashResponse, withDecodedEvents)
 }
 
 // hyperBlockByNonceHandler handles "by-nonce" requests
@@ -74,11 +94,174 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
+	withDecodedEvents, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedEvents)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
 	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce, options)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHyperblockError(c, err)
+		return
+	}
+
+	group.respondWithHyperblock(c, blockByNonceResponse, withDecodedEvents)
+}
+
+// hyperBlockByTimestampHandler will handle the fetching and returning the latest hyperblock produced at or before a
+// Unix timestamp
+func (group *hyperBlockGroup) hyperBlockByTimestampHandler(c *gin.Context) {
+	timestamp, err := shared.FetchTimestampFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseTimestamp.Error())
+		return
+	}
+
+	options, err := parseHyperblockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	withDecodedEvents, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedEvents)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blockByTimestampResponse, err := group.facade.GetHyperBlockByTimestamp(timestamp, options)
+	if err != nil {
+		respondWithHyperblockError(c, err)
+		return
+	}
+
+	group.respondWithHyperblock(c, blockByTimestampResponse, withDecodedEvents)
+}
+
+// respondWithHyperblockError responds with a distinct status and return code when the hyperblock is incomplete, so the
+// clients can tell it apart from the other failures and retry later
+func respondWithHyperblockError(c *gin.Context, err error) {
+	if errors.Is(err, data.ErrIncompleteHyperblock) {
+		shared.RespondWith(c, http.StatusServiceUnavailable, nil, err.Error(), data.ReturnCodeIncompleteHyperblock)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+}
+
+func (group *hyperBlockGroup) respondWithHyperblock(c *gin.Context, response *data.HyperblockApiResponse, withDecodedEvents bool) {
+	if !withDecodedEvents {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	hyperblock := group.facade.DecodeHyperblockEvents(&response.Data.Hyperblock)
+	responseData := gin.H{
+		"hyperblock": hyperblock,
+		"complete":   response.Data.Complete,
+	}
+	if len(response.Data.MissingShardBlocks) > 0 {
+		responseData["missingShardBlocks"] = response.Data.MissingShardBlocks
+	}
+
+	shared.RespondWith(c, http.StatusOK, responseData, response.Error, response.Code)
+}
+
+// hyperBlocksRangeHandler streams the hyperblocks between two nonces as NDJSON, in nonce order. A complete stream ends
+// with a done line, while an interrupted one ends with an error line
+func (group *hyperBlockGroup) hyperBlocksRangeHandler(c *gin.Context) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	if !fromNonce.HasValue || !toNonce.HasValue || fromNonce.Value > toNonce.Value {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, ErrInvalidHyperblocksRange)
+		return
+	}
+	if toNonce.Value-fromNonce.Value >= common.MaxHyperblocksRangeSize {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: at most %d hyperblocks can be requested at once", apiErrors.ErrBadUrlParams.Error(), common.MaxHyperblocksRangeSize))
+		return
+	}
+
+	options, err := parseHyperblockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	numStreamedHyperblocks := uint64(0)
+	writer := shared.NewNDJSONStreamWriter(c)
+	err = group.facade.StreamHyperBlocks(fromNonce.Value, toNonce.Value, options, func(hyperblock *api.Hyperblock) error {
+		errWrite := writer.WriteLine(hyperblock)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		numStreamedHyperblocks++
+		writer.Flush()
+		return nil
+	})
+	if err == nil {
+		_ = writer.WriteLine(data.HyperblocksStreamEnd{Done: true, NumHyperblocks: numStreamedHyperblocks})
+		writer.Flush()
+		return
+	}
+	if !writer.IsStarted() {
+		shared.RespondWithInternalError(c, apiErrors.ErrStreamHyperblocks, err)
+		return
+	}
+
+	_ = writer.WriteLine(data.StreamError{Error: fmt.Sprintf("%s: %s", apiErrors.ErrStreamHyperblocks.Error(), err.Error())})
+	writer.Flush()
+}
+
+// hyperBlocksStreamHandler pushes, as server-sent events, the hyperblocks starting from the provided nonce and then
+// each new one as soon as it is fully synchronized across shards. Each event ID is the hyperblock nonce, so that a
+// reconnecting client resumes right after the last received hyperblock
+func (group *hyperBlockGroup) hyperBlocksStreamHandler(c *gin.Context) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	lastEventID := c.GetHeader(shared.LastEventIDHeader)
+	if len(lastEventID) > 0 {
+		lastNonce, errParse := strconv.ParseUint(lastEventID, 10, 64)
+		if errParse != nil {
+			shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, ErrInvalidLastEventID)
+			return
+		}
+
+		fromNonce = core.OptionalUint64{Value: lastNonce + 1, HasValue: true}
+	}
+
+	options, err := parseHyperblockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	writer := shared.NewSSEStreamWriter(c)
+	err = group.facade.FollowHyperBlocks(c.Request.Context(), fromNonce, options, func(hyperblock *api.Hyperblock) error {
+		return writer.WriteEvent(strconv.FormatUint(hyperblock.Nonce, 10), hyperblockEventName, hyperblock)
+	})
+	if err == nil || c.Request.Context().Err() != nil {
+		return
+	}
+	if !writer.IsStarted() {
+		shared.RespondWithInternalError(c, apiErrors.ErrFollowHyperblocks, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	_ = writer.WriteEvent("", errorEventName, data.StreamError{Error: fmt.Sprintf("%s: %s", apiErrors.ErrFollowHyperblocks.Error(), err.Error())})
 }
@@ -1,16 +1,25 @@
 package groups_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -95,6 +104,150 @@ func TestGetHyperblockByNonce(t *testing.T) {
 	require.Equal(t, "invalid block hash parameter", response.Error)
 }
 
+func TestGetHyperblockWithDecodedEvents(t *testing.T) {
+	type hyperblockWithDecodedEventsResponse struct {
+		Data struct {
+			Hyperblock data.HyperblockWithDecodedEvents `json:"hyperblock"`
+		} `json:"data"`
+		Error string          `json:"error"`
+		Code  data.ReturnCode `json:"code"`
+	}
+
+	facade := &mock.FacadeStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(api.Hyperblock{
+				Nonce:        nonce,
+				Transactions: []*transaction.ApiTransactionResult{{Hash: "tx"}},
+			}), nil
+		},
+		DecodeHyperblockEventsCalled: func(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents {
+			return &data.HyperblockWithDecodedEvents{
+				Hyperblock: hyperblock,
+				Transactions: []*data.TransactionWithDecodedEvents{
+					{
+						ApiTransactionResult: hyperblock.Transactions[0],
+						Logs:                 &data.LogsWithDecodedEvents{Address: "decoded"},
+					},
+				},
+			}
+		},
+	}
+
+	// Get with success
+	response := hyperblockWithDecodedEventsResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-nonce/42?withDecodedEvents=true", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "successful", string(response.Code))
+	require.Equal(t, uint64(42), response.Data.Hyperblock.Nonce)
+	require.Len(t, response.Data.Hyperblock.Transactions, 1)
+	require.Equal(t, "tx", response.Data.Hyperblock.Transactions[0].Hash)
+	require.Equal(t, "decoded", response.Data.Hyperblock.Transactions[0].Logs.Address)
+
+	// Bad parameter
+	response = hyperblockWithDecodedEventsResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?withDecodedEvents=foo", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestGetHyperblockCompleteness(t *testing.T) {
+	facade := &mock.FacadeStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			if !options.AllowPartial {
+				return nil, fmt.Errorf("%w: shard 1, nonce 40, hash one", data.ErrIncompleteHyperblock)
+			}
+
+			response := data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce})
+			response.Data.Complete = false
+			response.Data.MissingShardBlocks = []*data.MissingShardBlock{{Shard: 1, Nonce: 40, Hash: "one", Reason: "sending request error"}}
+			return response, nil
+		},
+		GetHyperBlockByHashCalled: func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return nil, data.ErrIncompleteHyperblock
+		},
+		GetHyperBlockByTimestampCalled: func(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return nil, data.ErrIncompleteHyperblock
+		},
+		DecodeHyperblockEventsCalled: func(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents {
+			return &data.HyperblockWithDecodedEvents{Hyperblock: hyperblock}
+		},
+	}
+
+	// Strict by default
+	response := data.HyperblockApiResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-nonce/42", &response)
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+	require.Equal(t, data.ReturnCodeIncompleteHyperblock, response.Code)
+	require.True(t, strings.Contains(response.Error, "incomplete hyperblock"))
+
+	// Partial hyperblock
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?allowPartial=true", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.False(t, response.Data.Complete)
+	require.Equal(t, []*data.MissingShardBlock{{Shard: 1, Nonce: 40, Hash: "one", Reason: "sending request error"}}, response.Data.MissingShardBlocks)
+
+	// Partial hyperblock with decoded events
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?allowPartial=true&withDecodedEvents=true", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.False(t, response.Data.Complete)
+	require.Len(t, response.Data.MissingShardBlocks, 1)
+
+	// Bad parameter
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?allowPartial=foo", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+
+	// The other hyperblock endpoints
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-hash/abcd", &response)
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+	require.Equal(t, data.ReturnCodeIncompleteHyperblock, response.Code)
+
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-timestamp/1700000000", &response)
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+	require.Equal(t, data.ReturnCodeIncompleteHyperblock, response.Code)
+}
+
+func TestGetHyperblockByTimestamp(t *testing.T) {
+	facade := &mock.FacadeStub{
+		GetHyperBlockByTimestampCalled: func(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			if timestamp == 1700000000 {
+				require.True(t, options.WithLogs)
+				return data.NewHyperblockApiResponse(api.Hyperblock{
+					Nonce:     42,
+					Timestamp: 1699999998,
+				}), nil
+			}
+
+			return nil, fmt.Errorf("fooError")
+		},
+	}
+
+	// Get with success
+	response := data.HyperblockApiResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-timestamp/1700000000?withLogs=true", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "successful", string(response.Code))
+	require.Equal(t, "", response.Error)
+	require.Equal(t, 42, int(response.Data.Hyperblock.Nonce))
+
+	// Facade error
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-timestamp/1", &response)
+	require.Equal(t, http.StatusInternalServerError, statusCode)
+	require.Equal(t, "internal_issue", string(response.Code))
+	require.Equal(t, "fooError", response.Error)
+
+	// Bad timestamp
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-timestamp/badtimestamp", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, "bad_request", string(response.Code))
+	require.Equal(t, apiErrors.ErrCannotParseTimestamp.Error(), response.Error)
+}
+
 func doGet(t *testing.T, facade interface{}, url string, response interface{}) int {
 	hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
 	require.NoError(t, err)
@@ -108,3 +261,237 @@ func doGet(t *testing.T, facade interface{}, url string, response interface{}) i
 	loadResponse(responseRecorder.Body, &response)
 	return responseRecorder.Code
 }
+
+func TestHyperBlockGroup_StreamHyperBlocksRange(t *testing.T) {
+	t.Parallel()
+
+	readLines := func(t *testing.T, body *bytes.Buffer) []map[string]interface{} {
+		lines := make([]map[string]interface{}, 0)
+		decoder := json.NewDecoder(body)
+		for decoder.More() {
+			line := make(map[string]interface{})
+			require.NoError(t, decoder.Decode(&line))
+			lines = append(lines, line)
+		}
+
+		return lines
+	}
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		invalidQueries := []string{
+			"",
+			"fromNonce=10",
+			"toNonce=10",
+			"fromNonce=abc&toNonce=10",
+			"fromNonce=10&toNonce=9",
+			"fromNonce=10&toNonce=110",
+			"fromNonce=10&toNonce=11&withLogs=maybe",
+		}
+		for _, query := range invalidQueries {
+			req, _ := http.NewRequest("GET", "/hyperblock/range?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+		}
+	})
+	t.Run("error before the first hyperblock should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			StreamHyperBlocksCalled: func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				return expectedErr
+			},
+		}
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/range?fromNonce=10&toNonce=11", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should stream the hyperblocks", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamHyperBlocksCalled: func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				assert.Equal(t, uint64(10), fromNonce)
+				assert.Equal(t, uint64(11), toNonce)
+				assert.True(t, options.WithLogs)
+				assert.True(t, options.NotarizedAtSource)
+
+				_ = handler(&api.Hyperblock{Nonce: 10, Hash: "aa"})
+				return handler(&api.Hyperblock{Nonce: 11, Hash: "bb"})
+			},
+		}
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/range?fromNonce=10&toNonce=11&withLogs=true&notarizedAtSource=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		lines := readLines(t, resp.Body)
+		require.Len(t, lines, 3)
+		assert.Equal(t, float64(10), lines[0]["nonce"])
+		assert.Equal(t, "aa", lines[0]["hash"])
+		assert.Equal(t, float64(11), lines[1]["nonce"])
+		assert.Equal(t, "bb", lines[1]["hash"])
+		assert.Equal(t, map[string]interface{}{"done": true, "numHyperblocks": float64(2)}, lines[2])
+	})
+	t.Run("error after the first hyperblock should end the stream with an error line", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			StreamHyperBlocksCalled: func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				_ = handler(&api.Hyperblock{Nonce: 10})
+				return errors.New("observer went offline")
+			},
+		}
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/range?fromNonce=10&toNonce=12", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		lines := readLines(t, resp.Body)
+		require.Len(t, lines, 2)
+		assert.True(t, strings.Contains(lines[1]["error"].(string), "observer went offline"))
+	})
+}
+
+func TestHyperBlockGroup_FollowHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		invalidRequests := []struct {
+			query       string
+			lastEventID string
+		}{
+			{query: "fromNonce=abc"},
+			{query: "withLogs=maybe"},
+			{query: "fromNonce=10", lastEventID: "not-a-nonce"},
+			{query: "fromNonce=10", lastEventID: "-1"},
+		}
+		for _, request := range invalidRequests {
+			req, _ := http.NewRequest("GET", "/hyperblock/stream?"+request.query, nil)
+			if len(request.lastEventID) > 0 {
+				req.Header.Set("Last-Event-ID", request.lastEventID)
+			}
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := GeneralResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, request)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), request)
+		}
+	})
+	t.Run("error before the first hyperblock should respond with internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				return expectedErr
+			},
+		}
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/stream", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should push the hyperblocks as events", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				assert.Equal(t, core.OptionalUint64{Value: 10, HasValue: true}, fromNonce)
+				assert.True(t, options.WithLogs)
+
+				_ = handler(&api.Hyperblock{Nonce: 10, Hash: "aa"})
+				_ = handler(&api.Hyperblock{Nonce: 11, Hash: "bb"})
+				return errors.New("observer went offline")
+			},
+		}
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/stream?fromNonce=10&withLogs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+
+		events := strings.Split(strings.TrimSpace(resp.Body.String()), "\n\n")
+		require.Len(t, events, 3)
+		assert.True(t, strings.HasPrefix(events[0], "id: 10\nevent: hyperblock\ndata: {"))
+		assert.True(t, strings.Contains(events[0], `"hash":"aa"`))
+		assert.True(t, strings.HasPrefix(events[1], "id: 11\nevent: hyperblock\ndata: {"))
+		assert.True(t, strings.HasPrefix(events[2], "event: error\ndata: {\"error\":"))
+		assert.True(t, strings.Contains(events[2], "observer went offline"))
+	})
+	t.Run("last event ID should resume after the last received hyperblock", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				assert.Equal(t, core.OptionalUint64{Value: 43, HasValue: true}, fromNonce)
+				return handler(&api.Hyperblock{Nonce: 43})
+			},
+		}
+		hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(hyperBlockGroup, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/stream?fromNonce=10", nil)
+		req.Header.Set("Last-Event-ID", "42")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, strings.HasPrefix(resp.Body.String(), "id: 43\n"))
+	})
+}
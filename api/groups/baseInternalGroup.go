@@ -33,6 +33,7 @@ func NewInternalGroup(facadeHandler data.FacadeHandler) (*internalGroup, error)
 		{Path: "/:shard/raw/block/by-hash/:hash", Handler: bg.rawBlockbyHashHandler, Method: http.MethodGet},
 		{Path: "/:shard/json/block/by-nonce/:nonce", Handler: bg.internalBlockbyNonceHandler, Method: http.MethodGet},
 		{Path: "/:shard/json/block/by-hash/:hash", Handler: bg.internalBlockbyHashHandler, Method: http.MethodGet},
+		{Path: "/:shard/json/miniblock/by-hash/:hash", Handler: bg.internalMiniBlockbyHashWithoutEpochHandler, Method: http.MethodGet},
 		{Path: "/:shard/json/miniblock/by-hash/:hash/epoch/:epoch", Handler: bg.internalMiniBlockbyHashHandler, Method: http.MethodGet},
 		{Path: "/:shard/raw/miniblock/by-hash/:hash/epoch/:epoch", Handler: bg.rawMiniBlockbyHashHandler, Method: http.MethodGet},
 		{Path: "/raw/startofepoch/metablock/by-epoch/:epoch", Handler: bg.rawStartOfEpochMetaBlock, Method: http.MethodGet},
@@ -234,6 +235,47 @@ func (group *internalGroup) internalMiniBlockbyHashHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, miniBlockByHashResponse)
 }
 
+// internalMiniBlockbyHashWithoutEpochHandler will handle the fetching and returning a miniblock based on its hash, when
+// its epoch is not known. The optional txHash and blockHash URL parameters help finding the epoch
+func (group *internalGroup) internalMiniBlockbyHashWithoutEpochHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrCannotParseShardID.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	hash := c.Param("hash")
+	_, err = hex.DecodeString(hash)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrInvalidBlockHashParam.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	hints := common.MiniBlockEpochHints{
+		TxHash:    parseStringUrlParam(c, common.UrlParameterTxHash),
+		BlockHash: parseStringUrlParam(c, common.UrlParameterBlockHash),
+	}
+	miniBlockByHashResponse, err := group.facade.GetInternalMiniBlockByHashWithoutEpoch(shardID, hash, hints, common.Internal)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, miniBlockByHashResponse)
+}
+
 // rawMiniBlockbyHashHandler will handle the fetching and returning a miniblock based on its hash
 func (group *internalGroup) rawMiniBlockbyHashHandler(c *gin.Context) {
 	shardID, err := shared.FetchShardIDFromRequest(c)
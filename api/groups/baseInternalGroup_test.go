@@ -619,6 +619,120 @@ func TestGetInternalMiniBlockByHash_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, apiResp.Error)
 }
 
+// ---- InternalMiniBlockByHashWithoutEpoch
+
+func TestGetInternalMiniBlockByHashWithoutEpoch_FailWhenShardParamIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{}
+	internalGroup, err := groups.NewInternalGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(internalGroup, internalPath)
+
+	req, _ := http.NewRequest("GET", "/internal/invalid_shard_id/json/miniblock/by-hash/aaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := &internalBlockResponse{}
+	loadResponse(resp.Body, apiResp)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Empty(t, apiResp.Data)
+	assert.Equal(t, apiErrors.ErrCannotParseShardID.Error(), apiResp.Error)
+}
+
+func TestGetInternalMiniBlockByHashWithoutEpoch_FailWhenHashParamIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{}
+	internalGroup, err := groups.NewInternalGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(internalGroup, internalPath)
+
+	req, _ := http.NewRequest("GET", "/internal/0/json/miniblock/by-hash/invalid-hash", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := &internalBlockResponse{}
+	loadResponse(resp.Body, apiResp)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Empty(t, apiResp.Data)
+	assert.Equal(t, apiErrors.ErrInvalidBlockHashParam.Error(), apiResp.Error)
+}
+
+func TestGetInternalMiniBlockByHashWithoutEpoch_FailWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	returnedError := errors.New("i am an error")
+	facade := &mock.FacadeStub{
+		GetInternalMiniBlockByHashWithoutEpochCalled: func(_ uint32, _ string, _ common.MiniBlockEpochHints, _ common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+			return &data.InternalMiniBlockApiResponse{}, returnedError
+		},
+	}
+	internalGroup, err := groups.NewInternalGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(internalGroup, internalPath)
+
+	req, _ := http.NewRequest("GET", "/internal/0/json/miniblock/by-hash/aaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := &internalBlockResponse{}
+	loadResponse(resp.Body, apiResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Empty(t, apiResp.Data)
+	assert.Equal(t, returnedError.Error(), apiResp.Error)
+}
+
+func TestGetInternalMiniBlockByHashWithoutEpoch_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(1)
+	hash := "aaaa"
+
+	ts := &testStruct{
+		Nonce: nonce,
+		Hash:  hash,
+	}
+
+	expectedData := &data.InternalMiniBlockApiResponse{
+		Data: data.InternalMiniBlockApiResponsePayload{MiniBlock: ts},
+	}
+
+	var receivedHints common.MiniBlockEpochHints
+	facade := &mock.FacadeStub{
+		GetInternalMiniBlockByHashWithoutEpochCalled: func(shardID uint32, mbHash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+			assert.Equal(t, uint32(0), shardID)
+			assert.Equal(t, hash, mbHash)
+			assert.Equal(t, common.Internal, format)
+			receivedHints = hints
+			return expectedData, nil
+		},
+	}
+	internalGroup, err := groups.NewInternalGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(internalGroup, internalPath)
+
+	req, _ := http.NewRequest("GET", "/internal/0/json/miniblock/by-hash/aaaa?txHash=bbbb&blockHash=cccc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := &internalMiniBlockResponse{}
+	loadResponse(resp.Body, apiResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, nonce, apiResp.Data.Block.Nonce)
+	assert.Equal(t, hash, apiResp.Data.Block.Hash)
+	assert.Empty(t, apiResp.Error)
+	assert.Equal(t, common.MiniBlockEpochHints{TxHash: "bbbb", BlockHash: "cccc"}, receivedHints)
+}
+
 // ---- RawMiniBlockByHash
 
 func TestGetRawMiniBlockByHash_FailWhenShardParamIsInvalid(t *testing.T) {
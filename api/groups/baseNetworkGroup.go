@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -44,6 +45,8 @@ func NewNetworkGroup(facadeHandler data.FacadeHandler) (*networkGroup, error) {
 		{Path: "/gas-configs", Handler: ng.getGasConfigs, Method: http.MethodGet},
 		{Path: "/trie-statistics/:shard", Handler: ng.getTrieStatistics, Method: http.MethodGet},
 		{Path: "/epoch-start/:shard/by-epoch/:epoch", Handler: ng.getEpochStartData, Method: http.MethodGet},
+		{Path: "/epochs", Handler: ng.getEpochsBoundaries, Method: http.MethodGet},
+		{Path: "/epochs/:epoch", Handler: ng.getEpochBoundaries, Method: http.MethodGet},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -238,3 +241,52 @@ func (group *networkGroup) getEpochStartData(c *gin.Context) {
 
 	c.JSON(http.StatusOK, epochStartData)
 }
+
+// getEpochBoundaries will expose the first and the last block of each shard in the given epoch
+func (group *networkGroup) getEpochBoundaries(c *gin.Context) {
+	epoch, err := shared.FetchEpochFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("error while parsing the epoch: %s", err.Error()))
+		return
+	}
+
+	epochBoundaries, err := group.facade.GetEpochBoundaries(epoch)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, epochBoundaries)
+}
+
+// getEpochsBoundaries will expose the first and the last block of each shard in the epochs of the given range
+func (group *networkGroup) getEpochsBoundaries(c *gin.Context) {
+	fromEpoch, err := parseUint32UrlParam(c, common.UrlParameterFromEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	toEpoch, err := parseUint32UrlParam(c, common.UrlParameterToEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	if !fromEpoch.HasValue || !toEpoch.HasValue || fromEpoch.Value > toEpoch.Value {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, ErrInvalidEpochsRange)
+		return
+	}
+	if toEpoch.Value-fromEpoch.Value >= common.MaxEpochsRangeSize {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: at most %d epochs can be requested at once", errors.ErrBadUrlParams.Error(), common.MaxEpochsRangeSize))
+		return
+	}
+
+	epochsBoundaries, err := group.facade.GetEpochsBoundaries(fromEpoch.Value, toEpoch.Value)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, epochsBoundaries)
+}
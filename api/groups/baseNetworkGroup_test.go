@@ -580,6 +580,144 @@ func TestEpochStartData_ShouldWork(t *testing.T) {
 	require.True(t, wasFacadeCalled)
 }
 
+func TestGetEpochBoundaries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/epochs/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("epoch has not started yet")
+		facade := &mock.FacadeStub{
+			GetEpochBoundariesCalled: func(epoch uint32) (*data.EpochBoundariesApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/epochs/100", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.EpochBoundariesApiResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBoundaries := &data.EpochBoundaries{
+			Epoch:      37,
+			IsComplete: true,
+			Shards: []*data.ShardEpochBoundaries{
+				{
+					ShardID: 0,
+					First:   &data.EpochBlockBoundary{Nonce: 100, Round: 101, Timestamp: 1000},
+					Last:    &data.EpochBlockBoundary{Nonce: 199, Round: 202, Timestamp: 1606},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetEpochBoundariesCalled: func(epoch uint32) (*data.EpochBoundariesApiResponse, error) {
+				require.Equal(t, uint32(37), epoch)
+				return &data.EpochBoundariesApiResponse{
+					Data: data.EpochBoundariesApiResponsePayload{Epoch: expectedBoundaries},
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/epochs/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.EpochBoundariesApiResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, expectedBoundaries, response.Data.Epoch)
+	})
+}
+
+func TestGetEpochsBoundaries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{
+			GetEpochsBoundariesCalled: func(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		invalidRequests := []string{
+			"/network/epochs",
+			"/network/epochs?fromEpoch=3",
+			"/network/epochs?fromEpoch=3&toEpoch=2",
+			"/network/epochs?fromEpoch=a&toEpoch=2",
+			"/network/epochs?fromEpoch=0&toEpoch=20",
+		}
+		for _, path := range invalidRequests {
+			req, _ := http.NewRequest("GET", path, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			require.Equal(t, http.StatusBadRequest, resp.Code, path)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetEpochsBoundariesCalled: func(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error) {
+				require.Equal(t, uint32(3), fromEpoch)
+				require.Equal(t, uint32(4), toEpoch)
+				return &data.EpochsBoundariesApiResponse{
+					Data: data.EpochsBoundariesApiResponsePayload{
+						Epochs: []*data.EpochBoundaries{{Epoch: 3, IsComplete: true}, {Epoch: 4}},
+					},
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/epochs?fromEpoch=3&toEpoch=4", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.EpochsBoundariesApiResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, response.Data.Epochs, 2)
+		require.True(t, response.Data.Epochs[0].IsComplete)
+		require.False(t, response.Data.Epochs[1].IsComplete)
+	})
+}
+
 func TestGetTriesStatistics_ShouldWork(t *testing.T) {
 	t.Parallel()
 
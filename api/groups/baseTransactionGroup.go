@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -60,6 +61,23 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
+	dryRun, err := parseBoolUrlParam(c, common.UrlParameterDryRun)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterDryRun.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	if dryRun {
+		statusCode, dryRunResult, errDryRun := group.facade.DryRunTransaction(&tx)
+		if errDryRun != nil {
+			shared.RespondWith(c, statusCode, nil, errDryRun.Error(), data.ReturnCodeInternalError)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, gin.H{"dryRun": dryRunResult}, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	statusCode, txHash, err := group.facade.SendTransaction(&tx)
 	if err != nil {
 		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
@@ -125,6 +143,29 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
+	dryRun, err := parseBoolUrlParam(c, common.UrlParameterDryRun)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterDryRun.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	if dryRun {
+		dryRunResponse, errDryRun := group.facade.DryRunMultipleTransactions(txs)
+		if errDryRun != nil {
+			shared.RespondWith(
+				c,
+				http.StatusInternalServerError,
+				nil,
+				fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), errDryRun.Error()),
+				data.ReturnCodeInternalError,
+			)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, gin.H{"dryRun": dryRunResponse}, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	response, err := group.facade.SendMultipleTransactions(txs)
 	if err != nil {
 		shared.RespondWith(
@@ -197,12 +238,27 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 		return
 	}
 
+	withFeeBreakdown, err := parseBoolUrlParam(c, common.UrlParameterWithFeeBreakdown)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterWithFeeBreakdown.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
 	cost, err := group.facade.TransactionCostRequest(&tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
+	// a cost estimation that ended with an error message has no meaningful fee breakdown
+	if withFeeBreakdown && len(cost.RetMessage) == 0 {
+		cost.FeeBreakdown, err = group.facade.GetTransactionCostFeeBreakdown(&tx, cost)
+		if err != nil {
+			shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+			return
+		}
+	}
+
 	shared.RespondWith(c, http.StatusOK, cost, "", data.ReturnCodeSuccess)
 }
 
@@ -233,9 +289,21 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	withFeeBreakdown, err := parseBoolUrlParam(c, common.UrlParameterWithFeeBreakdown)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterWithFeeBreakdown.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	withDecodedEvents, err := parseBoolUrlParam(c, common.UrlParameterWithDecodedEvents)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterWithDecodedEvents.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
 	sndAddr := c.Request.URL.Query().Get("sender")
 	if sndAddr != "" {
-		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options.WithResults)
+		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options.WithResults, withFeeBreakdown, withDecodedEvents)
 		return
 	}
 
@@ -245,7 +313,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	respondWithTransaction(c, group.facade, tx, withFeeBreakdown, withDecodedEvents)
 }
 
 func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
@@ -261,10 +329,15 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"status": status.Status, "reason": status.Reason}, "", data.ReturnCodeSuccess)
+	response := gin.H{"status": status.Status, "reason": status.Reason}
+	if status.Failure != nil {
+		response["failure"] = status.Failure
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
-func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
+func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool, withFeeBreakdown bool, withDecodedEvents bool) {
 	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
@@ -275,7 +348,27 @@ func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHa
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	respondWithTransaction(c, ef, tx, withFeeBreakdown, withDecodedEvents)
+}
+
+func respondWithTransaction(c *gin.Context, ef TransactionFacadeHandler, tx *transaction.ApiTransactionResult, withFeeBreakdown bool, withDecodedEvents bool) {
+	var txPayload interface{} = tx
+	if withDecodedEvents {
+		txPayload = ef.DecodeTransactionEvents(tx)
+	}
+
+	if !withFeeBreakdown {
+		shared.RespondWith(c, http.StatusOK, gin.H{"transaction": txPayload}, "", data.ReturnCodeSuccess)
+		return
+	}
+
+	feeBreakdown, err := ef.GetTransactionFeeBreakdown(tx)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": txPayload, "feeBreakdown": feeBreakdown}, "", data.ReturnCodeSuccess)
 }
 
 // getTransactionsPool should return transactions from pool
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
@@ -74,8 +75,9 @@ type nonceGapsResp struct {
 type txProcessedStatusResp struct {
 	GeneralResponse
 	Data struct {
-		Status string `json:"status"`
-		Reason string `json:"reason"`
+		Status  string                            `json:"status"`
+		Reason  string                            `json:"reason"`
+		Failure *data.ProcessStatusFailureDetails `json:"failure"`
 	} `json:"data"`
 }
 
@@ -400,6 +402,161 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, uint64(10), response.Data.Num)
 }
 
+func TestSendTransaction_DryRun(t *testing.T) {
+	t.Parallel()
+
+	type dryRunResp struct {
+		GeneralResponse
+		Data struct {
+			DryRun *data.TransactionDryRunResult `json:"dryRun"`
+		} `json:"data"`
+	}
+
+	jsonStr := `{"nonce": 1, "sender": "erd1sender", "receiver": "erd1receiver", "value": "10", "signature": "aabbccdd"}`
+	t.Run("invalid dryRun should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?dryRun=not-a-bool", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrValidationQueryParameterDryRun.Error(), response.Error)
+	})
+	t.Run("dry run validation error should respond with bad request, as send does", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			DryRunTransactionCalled: func(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+				return http.StatusBadRequest, nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?dryRun=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("dry run error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			DryRunTransactionCalled: func(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+				return http.StatusInternalServerError, nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?dryRun=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should not send the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		providedResult := &data.TransactionDryRunResult{
+			TxHash:   "hash",
+			Observer: "observer",
+			SimulationResults: map[string]data.TransactionSimulationResults{
+				"senderShard": {Status: "success", Hash: "hash"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+			DryRunTransactionCalled: func(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+				assert.Equal(t, "erd1sender", tx.Sender)
+				return http.StatusOK, providedResult, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send?dryRun=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := dryRunResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedResult, response.Data.DryRun)
+	})
+}
+
+func TestSendMultipleTransactions_DryRun(t *testing.T) {
+	t.Parallel()
+
+	type dryRunMultipleResp struct {
+		GeneralResponse
+		Data struct {
+			DryRun *data.MultipleTransactionsDryRunResponseData `json:"dryRun"`
+		} `json:"data"`
+	}
+
+	providedResponse := &data.MultipleTransactionsDryRunResponseData{
+		NumOfTxs: 1,
+		Results: map[int]*data.TransactionDryRunResult{
+			0: {TxHash: "hash", Observer: "observer"},
+		},
+		Errors: map[int]string{
+			1: "invalid sender address",
+		},
+	}
+	facade := &mock.FacadeStub{
+		SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			assert.Fail(t, "should have not been called")
+			return data.MultipleTransactionsResponseData{}, nil
+		},
+		DryRunMultipleTransactionsCalled: func(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error) {
+			assert.Len(t, txs, 2)
+			return providedResponse, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := `[{"nonce": 1, "sender": "erd1sender", "receiver": "erd1receiver", "value": "10"}, {"nonce": 2, "sender": "invalid", "receiver": "erd1receiver", "value": "10"}]`
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple?dryRun=true", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := dryRunMultipleResp{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, providedResponse, response.Data.DryRun)
+}
+
 func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
 	t.Parallel()
 
@@ -827,5 +984,282 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		assert.Empty(t, response.Error)
 		assert.Equal(t, status.Status, response.Data.Status)
 		assert.Equal(t, status.Reason, response.Data.Reason)
+		assert.Nil(t, response.Data.Failure)
+	})
+	t.Run("failed with details should work", func(t *testing.T) {
+		t.Parallel()
+
+		status := &data.ProcessStatusResponse{
+			Status: "fail",
+			Reason: "@75736572206572726f72",
+			Failure: &data.ProcessStatusFailureDetails{
+				Rule:       data.FailureRuleSignalError,
+				SourceHash: "scr hash",
+				Message:    "user error",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetProcessedTransactionStatusHandler: func(txHash string) (*data.ProcessStatusResponse, error) {
+				return status, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/"+hash+"/process-status", nil)
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txProcessedStatusResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, status.Status, response.Data.Status)
+		assert.Equal(t, status.Failure, response.Data.Failure)
+	})
+}
+
+func TestTransactionGroup_getTransactionWithFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	type txWithFeeBreakdownResp struct {
+		GeneralResponse
+		Data struct {
+			Transaction  *transaction.ApiTransactionResult `json:"transaction"`
+			FeeBreakdown *data.FeeBreakdown                `json:"feeBreakdown"`
+		} `json:"data"`
+	}
+
+	providedTx := &transaction.ApiTransactionResult{Hash: "hash", GasUsed: 50000}
+	providedBreakdown := &data.FeeBreakdown{GasUsed: 50000, Fee: "50000000000000", FeeDenominated: "0.00005"}
+	t.Run("invalid withFeeBreakdown should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withFeeBreakdown=not-a-bool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrValidationQueryParameterWithFeeBreakdown.Error(), response.Error)
+	})
+	t.Run("fee breakdown error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return providedTx, nil
+			},
+			GetTransactionFeeBreakdownCalled: func(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withFeeBreakdown=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return providedTx, nil
+			},
+			GetTransactionFeeBreakdownCalled: func(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error) {
+				assert.Equal(t, providedTx, tx)
+				return providedBreakdown, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withFeeBreakdown=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txWithFeeBreakdownResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedTx.Hash, response.Data.Transaction.Hash)
+		assert.Equal(t, providedBreakdown, response.Data.FeeBreakdown)
+	})
+	t.Run("with sender should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionByHashAndSenderAddressHandler: func(txHash string, sndAddr string, withResults bool) (*transaction.ApiTransactionResult, int, error) {
+				return providedTx, http.StatusOK, nil
+			},
+			GetTransactionFeeBreakdownCalled: func(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error) {
+				return providedBreakdown, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?sender=erd1sender&withFeeBreakdown=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txWithFeeBreakdownResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedBreakdown, response.Data.FeeBreakdown)
+	})
+}
+
+func TestTransactionGroup_getTransactionWithDecodedEvents(t *testing.T) {
+	t.Parallel()
+
+	type txWithDecodedEventsResp struct {
+		GeneralResponse
+		Data struct {
+			Transaction *data.TransactionWithDecodedEvents `json:"transaction"`
+		} `json:"data"`
+	}
+
+	providedTx := &transaction.ApiTransactionResult{Hash: "hash"}
+	decodedLogs := &data.LogsWithDecodedEvents{
+		Events: []*data.EventWithDecodedData{
+			{
+				Events:  &transaction.Events{Identifier: "completedTxEvent"},
+				Decoded: &data.DecodedEventData{TxHash: "abcd"},
+			},
+		},
+	}
+	t.Run("invalid withDecodedEvents should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withDecodedEvents=not-a-bool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrValidationQueryParameterWithDecodedEvents.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return providedTx, nil
+			},
+			DecodeTransactionEventsCalled: func(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents {
+				assert.Equal(t, providedTx, tx)
+				return &data.TransactionWithDecodedEvents{
+					ApiTransactionResult: tx,
+					Logs:                 decodedLogs,
+				}
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withDecodedEvents=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txWithDecodedEventsResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedTx.Hash, response.Data.Transaction.Hash)
+		assert.Equal(t, decodedLogs, response.Data.Transaction.Logs)
+	})
+}
+
+func TestTransactionGroup_requestTransactionCostWithFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	type txCostResp struct {
+		GeneralResponse
+		Data data.TxCostResponseData `json:"data"`
+	}
+
+	providedBreakdown := &data.FeeBreakdown{GasUsed: 50000, Fee: "50000000000000", FeeDenominated: "0.00005"}
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TransactionCostRequestHandler: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 50000}, nil
+			},
+			GetTransactionCostFeeBreakdownCalled: func(tx *data.Transaction, cost *data.TxCostResponseData) (*data.FeeBreakdown, error) {
+				assert.Equal(t, uint64(50000), cost.TxCost)
+				return providedBreakdown, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/cost?withFeeBreakdown=true", bytes.NewBuffer([]byte(`{"sender":"erd1sender"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txCostResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(50000), response.Data.TxCost)
+		assert.Equal(t, providedBreakdown, response.Data.FeeBreakdown)
+	})
+	t.Run("failed cost estimation should not compute the fee breakdown", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TransactionCostRequestHandler: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{RetMessage: "insufficient funds"}, nil
+			},
+			GetTransactionCostFeeBreakdownCalled: func(tx *data.Transaction, cost *data.TxCostResponseData) (*data.FeeBreakdown, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/cost?withFeeBreakdown=true", bytes.NewBuffer([]byte(`{"sender":"erd1sender"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txCostResp{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Nil(t, response.Data.FeeBreakdown)
 	})
 }
@@ -0,0 +1,52 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type usernameGroup struct {
+	facade UsernameFacadeHandler
+	*baseGroup
+}
+
+// NewUsernameGroup returns a new instance of usernameGroup
+func NewUsernameGroup(facadeHandler data.FacadeHandler) (*usernameGroup, error) {
+	facade, ok := facadeHandler.(UsernameFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	ug := &usernameGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:name", Handler: ug.resolveUsername, Method: http.MethodGet},
+	}
+	ug.baseGroup.endpoints = baseRoutesHandlers
+
+	return ug, nil
+}
+
+// resolveUsername returns the address the username parameter is registered for
+func (ug *usernameGroup) resolveUsername(c *gin.Context) {
+	name := c.Param("name")
+	if name == "" {
+		shared.RespondWithValidationError(c, errors.ErrResolveUsername, errors.ErrEmptyUsername)
+		return
+	}
+
+	resolution, err := ug.facade.ResolveUsername(name)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrResolveUsername, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"resolution": resolution}, "", data.ReturnCodeSuccess)
+}
@@ -0,0 +1,96 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usernamePath = "/username"
+
+type usernameResolutionResponse struct {
+	Data struct {
+		Resolution *data.UsernameResolution `json:"resolution"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewUsernameGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewUsernameGroup(&mock.WrongFacade{})
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewUsernameGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestUsernameGroup_ResolveUsername(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("username not found")
+		facade := &mock.FacadeStub{
+			ResolveUsernameCalled: func(username string) (*data.UsernameResolution, error) {
+				return nil, expectedErr
+			},
+		}
+		usernameGroup, err := groups.NewUsernameGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(usernameGroup, usernamePath)
+
+		req, _ := http.NewRequest(http.MethodGet, usernamePath+"/alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &usernameResolutionResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResolution := &data.UsernameResolution{Username: "alice.elrond", Address: "erd1alice", DNSAddress: "erd1dns"}
+		facade := &mock.FacadeStub{
+			ResolveUsernameCalled: func(username string) (*data.UsernameResolution, error) {
+				assert.Equal(t, "alice", username)
+				return expectedResolution, nil
+			},
+		}
+		usernameGroup, err := groups.NewUsernameGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(usernameGroup, usernamePath)
+
+		req, _ := http.NewRequest(http.MethodGet, usernamePath+"/alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &usernameResolutionResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedResolution, response.Data.Resolution)
+	})
+}
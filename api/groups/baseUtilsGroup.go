@@ -0,0 +1,125 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type utilsGroup struct {
+	facade UtilsFacadeHandler
+	*baseGroup
+}
+
+// NewUtilsGroup returns a new instance of utilsGroup
+func NewUtilsGroup(facadeHandler data.FacadeHandler) (*utilsGroup, error) {
+	facade, ok := facadeHandler.(UtilsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	ug := &utilsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/address/bech32-to-hex", Handler: ug.convertBech32AddressesToHex, Method: http.MethodPost},
+		{Path: "/address/hex-to-bech32", Handler: ug.convertHexAddressesToBech32, Method: http.MethodPost},
+		{Path: "/address/shard", Handler: ug.computeShardIDsForAddresses, Method: http.MethodPost},
+		{Path: "/address/validate", Handler: ug.validateAddresses, Method: http.MethodPost},
+		{Path: "/contract-address/:deployer/nonce/:nonce", Handler: ug.computeContractAddress, Method: http.MethodGet},
+	}
+	ug.baseGroup.endpoints = baseRoutesHandlers
+
+	return ug, nil
+}
+
+// convertBech32AddressesToHex will handle the request for converting a bulk of bech32 addresses to hex
+func (ug *utilsGroup) convertBech32AddressesToHex(c *gin.Context) {
+	addresses, ok := getAddressesFromRequest(c)
+	if !ok {
+		return
+	}
+
+	conversions := ug.facade.ConvertBech32AddressesToHex(addresses)
+	shared.RespondWith(c, http.StatusOK, gin.H{"addresses": conversions}, "", data.ReturnCodeSuccess)
+}
+
+// convertHexAddressesToBech32 will handle the request for converting a bulk of hex addresses to bech32
+func (ug *utilsGroup) convertHexAddressesToBech32(c *gin.Context) {
+	addresses, ok := getAddressesFromRequest(c)
+	if !ok {
+		return
+	}
+
+	conversions := ug.facade.ConvertHexAddressesToBech32(addresses)
+	shared.RespondWith(c, http.StatusOK, gin.H{"addresses": conversions}, "", data.ReturnCodeSuccess)
+}
+
+// computeShardIDsForAddresses will handle the request for computing the shard IDs of a bulk of addresses
+func (ug *utilsGroup) computeShardIDsForAddresses(c *gin.Context) {
+	addresses, ok := getAddressesFromRequest(c)
+	if !ok {
+		return
+	}
+
+	shardIDs := ug.facade.ComputeShardIDsForAddresses(addresses)
+	shared.RespondWith(c, http.StatusOK, gin.H{"addresses": shardIDs}, "", data.ReturnCodeSuccess)
+}
+
+// validateAddresses will handle the request for validating a bulk of addresses
+func (ug *utilsGroup) validateAddresses(c *gin.Context) {
+	addresses, ok := getAddressesFromRequest(c)
+	if !ok {
+		return
+	}
+
+	validations := ug.facade.ValidateAddresses(addresses)
+	shared.RespondWith(c, http.StatusOK, gin.H{"addresses": validations}, "", data.ReturnCodeSuccess)
+}
+
+// computeContractAddress will handle the request for computing the address of a smart contract deployed by a sender
+func (ug *utilsGroup) computeContractAddress(c *gin.Context) {
+	deployer := c.Param("deployer")
+	if deployer == "" {
+		shared.RespondWithValidationError(c, errors.ErrComputeContractAddress, errors.ErrEmptyAddress)
+		return
+	}
+
+	nonce, err := shared.FetchNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrComputeContractAddress, errors.ErrCannotParseNonce)
+		return
+	}
+
+	contractAddress, err := ug.facade.ComputeContractAddress(deployer, nonce)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrComputeContractAddress, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contract": contractAddress}, "", data.ReturnCodeSuccess)
+}
+
+// getAddressesFromRequest reads the bulk of addresses from the request body, responding with a bad request if the
+// bulk is empty, malformed or too large
+func getAddressesFromRequest(c *gin.Context) ([]string, bool) {
+	request := data.AddressesRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil || len(request.Addresses) == 0 {
+		shared.RespondWithBadRequest(c, errors.ErrInvalidAddressesArray.Error())
+		return nil, false
+	}
+	if len(request.Addresses) > common.MaxUtilsBulkAddresses {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: maximum %d", errors.ErrTooManyAddresses.Error(), common.MaxUtilsBulkAddresses))
+		return nil, false
+	}
+
+	return request.Addresses, true
+}
@@ -0,0 +1,256 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const utilsPath = "/utils"
+
+type addressConversionsResponse struct {
+	Data struct {
+		Addresses []*data.AddressConversion `json:"addresses"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type addressShardIDsResponse struct {
+	Data struct {
+		Addresses []*data.AddressShardID `json:"addresses"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type addressValidationsResponse struct {
+	Data struct {
+		Addresses []*data.AddressValidation `json:"addresses"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type contractAddressResponse struct {
+	Data struct {
+		Contract *data.ContractAddress `json:"contract"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func createAddressesRequestBody(addresses []string) *bytes.Buffer {
+	body, _ := json.Marshal(data.AddressesRequest{Addresses: addresses})
+	return bytes.NewBuffer(body)
+}
+
+func TestNewUtilsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewUtilsGroup(&mock.WrongFacade{})
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestUtilsGroup_BulkAddressesValidation(t *testing.T) {
+	t.Parallel()
+
+	utilsGroup, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+	require.Nil(t, err)
+	ws := startProxyServer(utilsGroup, utilsPath)
+
+	tooManyAddresses := make([]string, common.MaxUtilsBulkAddresses+1)
+	for _, endpoint := range []string{"bech32-to-hex", "hex-to-bech32", "shard", "validate"} {
+		for _, body := range []*bytes.Buffer{
+			bytes.NewBufferString("not a json"),
+			createAddressesRequestBody(nil),
+			createAddressesRequestBody(tooManyAddresses),
+		} {
+			req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/address/%s", utilsPath, endpoint), body)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := &data.GenericAPIResponse{}
+			loadResponse(resp.Body, response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, endpoint)
+			assert.NotEmpty(t, response.Error, endpoint)
+		}
+	}
+}
+
+func TestUtilsGroup_ConvertAddresses(t *testing.T) {
+	t.Parallel()
+
+	addresses := []string{"address1", "address2"}
+	facade := &mock.FacadeStub{
+		ConvertBech32AddressesToHexCalled: func(providedAddresses []string) []*data.AddressConversion {
+			assert.Equal(t, addresses, providedAddresses)
+			return []*data.AddressConversion{{Input: "address1", Output: "hex1"}, {Input: "address2", Error: "invalid"}}
+		},
+		ConvertHexAddressesToBech32Called: func(providedAddresses []string) []*data.AddressConversion {
+			assert.Equal(t, addresses, providedAddresses)
+			return []*data.AddressConversion{{Input: "address1", Output: "erd1"}, {Input: "address2", Output: "erd2"}}
+		},
+	}
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	require.Nil(t, err)
+	ws := startProxyServer(utilsGroup, utilsPath)
+
+	req, _ := http.NewRequest(http.MethodPost, utilsPath+"/address/bech32-to-hex", createAddressesRequestBody(addresses))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &addressConversionsResponse{}
+	loadResponse(resp.Body, response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, []*data.AddressConversion{{Input: "address1", Output: "hex1"}, {Input: "address2", Error: "invalid"}}, response.Data.Addresses)
+
+	req, _ = http.NewRequest(http.MethodPost, utilsPath+"/address/hex-to-bech32", createAddressesRequestBody(addresses))
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = &addressConversionsResponse{}
+	loadResponse(resp.Body, response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "erd2", response.Data.Addresses[1].Output)
+}
+
+func TestUtilsGroup_ComputeShardIDsForAddresses(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(2)
+	facade := &mock.FacadeStub{
+		ComputeShardIDsForAddressesCalled: func(addresses []string) []*data.AddressShardID {
+			return []*data.AddressShardID{{Address: addresses[0], ShardID: &shardID}}
+		},
+	}
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	require.Nil(t, err)
+	ws := startProxyServer(utilsGroup, utilsPath)
+
+	req, _ := http.NewRequest(http.MethodPost, utilsPath+"/address/shard", createAddressesRequestBody([]string{"address"}))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &addressShardIDsResponse{}
+	loadResponse(resp.Body, response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, []*data.AddressShardID{{Address: "address", ShardID: &shardID}}, response.Data.Addresses)
+}
+
+func TestUtilsGroup_ValidateAddresses(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ValidateAddressesCalled: func(addresses []string) []*data.AddressValidation {
+			return []*data.AddressValidation{{Address: addresses[0], IsValid: true}, {Address: addresses[1], Error: "invalid"}}
+		},
+	}
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	require.Nil(t, err)
+	ws := startProxyServer(utilsGroup, utilsPath)
+
+	req, _ := http.NewRequest(http.MethodPost, utilsPath+"/address/validate", createAddressesRequestBody([]string{"valid", "invalid"}))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &addressValidationsResponse{}
+	loadResponse(resp.Body, response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.True(t, response.Data.Addresses[0].IsValid)
+	require.False(t, response.Data.Addresses[1].IsValid)
+	require.Equal(t, "invalid", response.Data.Addresses[1].Error)
+}
+
+func TestUtilsGroup_ComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		utilsGroup, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest(http.MethodGet, utilsPath+"/contract-address/deployer/nonce/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.True(t, strings.Contains(response.Error, apiErrors.ErrCannotParseNonce.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid deployer")
+		facade := &mock.FacadeStub{
+			ComputeContractAddressCalled: func(deployer string, nonce uint64) (*data.ContractAddress, error) {
+				return nil, expectedErr
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest(http.MethodGet, utilsPath+"/contract-address/deployer/nonce/5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedContract := &data.ContractAddress{Address: "erd1contract", Hex: "0500", ShardID: 1}
+		facade := &mock.FacadeStub{
+			ComputeContractAddressCalled: func(deployer string, nonce uint64) (*data.ContractAddress, error) {
+				assert.Equal(t, "deployer", deployer)
+				assert.Equal(t, uint64(5), nonce)
+				return expectedContract, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.Nil(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest(http.MethodGet, utilsPath+"/contract-address/deployer/nonce/5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &contractAddressResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, expectedContract, response.Data.Contract)
+	})
+}
@@ -0,0 +1,91 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type webhooksGroup struct {
+	facade WebhooksFacadeHandler
+	*baseGroup
+}
+
+// NewWebhooksGroup returns a new instance of webhooksGroup
+func NewWebhooksGroup(facadeHandler data.FacadeHandler) (*webhooksGroup, error) {
+	facade, ok := facadeHandler.(WebhooksFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	wg := &webhooksGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/subscribe", Handler: wg.registerWebhookHandler, Method: http.MethodPost},
+		{Path: "/subscriptions", Handler: wg.getWebhooksHandler, Method: http.MethodGet},
+		{Path: "/subscriptions/:id", Handler: wg.removeWebhookHandler, Method: http.MethodDelete},
+		{Path: "/subscriptions/:id/deliveries", Handler: wg.getWebhookDeliveriesHandler, Method: http.MethodGet},
+	}
+	wg.baseGroup.endpoints = baseRoutesHandlers
+
+	return wg, nil
+}
+
+// registerWebhookHandler will handle the registration of a webhook, owned by the authenticated user
+func (group *webhooksGroup) registerWebhookHandler(c *gin.Context) {
+	request := data.WebhookSubscriptionRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrRegisterWebhook, err)
+		return
+	}
+
+	subscription, err := group.facade.RegisterWebhook(getWebhooksOwner(c), request)
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrRegisterWebhook, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"subscription": subscription}, "", data.ReturnCodeSuccess)
+}
+
+// getWebhooksHandler will handle the fetching of the webhooks of the authenticated user
+func (group *webhooksGroup) getWebhooksHandler(c *gin.Context) {
+	subscriptions := group.facade.GetWebhooks(getWebhooksOwner(c))
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"subscriptions": subscriptions}, "", data.ReturnCodeSuccess)
+}
+
+// removeWebhookHandler will handle the removal of a webhook of the authenticated user
+func (group *webhooksGroup) removeWebhookHandler(c *gin.Context) {
+	err := group.facade.RemoveWebhook(getWebhooksOwner(c), c.Param("id"))
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrRemoveWebhook, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"removed": true}, "", data.ReturnCodeSuccess)
+}
+
+// getWebhookDeliveriesHandler will handle the fetching of the delivery log of a webhook of the authenticated user
+func (group *webhooksGroup) getWebhookDeliveriesHandler(c *gin.Context) {
+	deliveries, err := group.facade.GetWebhookDeliveries(getWebhooksOwner(c), c.Param("id"))
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrGetWebhookDeliveries, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"deliveries": deliveries}, "", data.ReturnCodeSuccess)
+}
+
+// getWebhooksOwner returns the Basic Authentication user of the request, which owns the webhooks it manages
+func getWebhooksOwner(c *gin.Context) string {
+	user, _, _ := c.Request.BasicAuth()
+	return user
+}
@@ -0,0 +1,260 @@
+package groups_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webhooksPath = "/webhooks"
+
+type webhookSubscriptionResponse struct {
+	Data struct {
+		Subscription *data.WebhookSubscription `json:"subscription"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type webhookSubscriptionsResponse struct {
+	Data struct {
+		Subscriptions []*data.WebhookSubscription `json:"subscriptions"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type webhookDeliveriesResponse struct {
+	Data struct {
+		Deliveries []*data.WebhookDelivery `json:"deliveries"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewWebhooksGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewWebhooksGroup(&mock.WrongFacade{})
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewWebhooksGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestWebhooksGroup_RegisterWebhook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		webhooksGroup, _ := groups.NewWebhooksGroup(&mock.FacadeStub{})
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		req, _ := http.NewRequest(http.MethodPost, webhooksPath+"/subscribe", bytes.NewBufferString("not json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &webhookSubscriptionResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid webhook subscription")
+		facade := &mock.FacadeStub{
+			RegisterWebhookCalled: func(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+				return nil, expectedErr
+			},
+		}
+		webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		req, _ := http.NewRequest(http.MethodPost, webhooksPath+"/subscribe", bytes.NewBufferString(`{"url":"http://localhost"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &webhookSubscriptionResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RegisterWebhookCalled: func(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+				assert.Equal(t, "alice", owner)
+				assert.Equal(t, "http://localhost/hook", request.URL)
+				assert.Equal(t, []string{"TKN-abcdef"}, request.Filter.Tokens)
+
+				return &data.WebhookSubscription{ID: "id", Owner: owner, URL: request.URL, Secret: "secret", Filter: request.Filter}, nil
+			},
+		}
+		webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		body := `{"url":"http://localhost/hook","filter":{"tokens":["TKN-abcdef"]}}`
+		req, _ := http.NewRequest(http.MethodPost, webhooksPath+"/subscribe", bytes.NewBufferString(body))
+		req.SetBasicAuth("alice", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &webhookSubscriptionResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "id", response.Data.Subscription.ID)
+		assert.Equal(t, "secret", response.Data.Subscription.Secret)
+	})
+}
+
+func TestWebhooksGroup_GetWebhooks(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetWebhooksCalled: func(owner string) []*data.WebhookSubscription {
+			assert.Equal(t, "alice", owner)
+			return []*data.WebhookSubscription{{ID: "id1"}, {ID: "id2"}}
+		},
+	}
+	webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+	ws := startProxyServer(webhooksGroup, webhooksPath)
+
+	req, _ := http.NewRequest(http.MethodGet, webhooksPath+"/subscriptions", nil)
+	req.SetBasicAuth("alice", "password")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &webhookSubscriptionsResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Subscriptions, 2)
+	assert.Equal(t, "id2", response.Data.Subscriptions[1].ID)
+}
+
+func TestWebhooksGroup_RemoveWebhook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("webhook subscription not found")
+		facade := &mock.FacadeStub{
+			RemoveWebhookCalled: func(owner string, id string) error {
+				return expectedErr
+			},
+		}
+		webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		req, _ := http.NewRequest(http.MethodDelete, webhooksPath+"/subscriptions/id", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &webhookSubscriptionResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		removeCalled := false
+		facade := &mock.FacadeStub{
+			RemoveWebhookCalled: func(owner string, id string) error {
+				assert.Equal(t, "alice", owner)
+				assert.Equal(t, "id", id)
+				removeCalled = true
+				return nil
+			},
+		}
+		webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		req, _ := http.NewRequest(http.MethodDelete, webhooksPath+"/subscriptions/id", nil)
+		req.SetBasicAuth("alice", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, removeCalled)
+	})
+}
+
+func TestWebhooksGroup_GetWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("webhook subscription not found")
+		facade := &mock.FacadeStub{
+			GetWebhookDeliveriesCalled: func(owner string, id string) ([]*data.WebhookDelivery, error) {
+				return nil, expectedErr
+			},
+		}
+		webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		req, _ := http.NewRequest(http.MethodGet, webhooksPath+"/subscriptions/id/deliveries", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &webhookDeliveriesResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetWebhookDeliveriesCalled: func(owner string, id string) ([]*data.WebhookDelivery, error) {
+				assert.Equal(t, "alice", owner)
+				assert.Equal(t, "id", id)
+				return []*data.WebhookDelivery{{SubscriptionID: id, HyperblockNonce: 7, Delivered: true, Attempts: 1}}, nil
+			},
+		}
+		webhooksGroup, _ := groups.NewWebhooksGroup(facade)
+		ws := startProxyServer(webhooksGroup, webhooksPath)
+
+		req, _ := http.NewRequest(http.MethodGet, webhooksPath+"/subscriptions/id/deliveries", nil)
+		req.SetBasicAuth("alice", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &webhookDeliveriesResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, response.Data.Deliveries, 1)
+		assert.Equal(t, uint64(7), response.Data.Deliveries[0].HyperblockNonce)
+		assert.True(t, response.Data.Deliveries[0].Delivered)
+	})
+}
@@ -16,3 +16,33 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 
 // ErrForcedShardIDCannotBeProvided signals that the forced shard id cannot be provided for a different address other than the system account address
 var ErrForcedShardIDCannotBeProvided = errors.New("forced shard id parameter can only be provided for system accounts")
+
+// ErrInvalidBalanceHistoryRange signals that the provided balance history range is invalid
+var ErrInvalidBalanceHistoryRange = errors.New("invalid balance history range: provide either fromNonce and toNonce or fromEpoch and toEpoch, with from <= to and step > 0")
+
+// ErrTooManyBalanceHistoryPoints signals that the provided balance history range holds too many points
+var ErrTooManyBalanceHistoryPoints = errors.New("too many balance history points")
+
+// ErrInvalidAccountDiffRange signals that the provided account diff block range is invalid
+var ErrInvalidAccountDiffRange = errors.New("invalid account diff range: fromBlockNonce and toBlockNonce must be provided, with fromBlockNonce < toBlockNonce")
+
+// ErrInvalidEpochsRange signals that the provided epochs range is invalid
+var ErrInvalidEpochsRange = errors.New("invalid epochs range: fromEpoch and toEpoch must be provided, with fromEpoch <= toEpoch")
+
+// ErrInvalidTopicFilter signals that an invalid topic filter has been provided
+var ErrInvalidTopicFilter = errors.New("invalid topic filter")
+
+// ErrInvalidEventsSearchLimit signals that an invalid events search limit has been provided
+var ErrInvalidEventsSearchLimit = errors.New("invalid events search limit")
+
+// ErrInvalidHyperblocksRange signals that the provided hyperblocks nonce range is invalid
+var ErrInvalidHyperblocksRange = errors.New("invalid hyperblocks range: fromNonce and toNonce must be provided, with fromNonce <= toNonce")
+
+// ErrInvalidBlocksNonceRange signals that the provided blocks nonce range is invalid
+var ErrInvalidBlocksNonceRange = errors.New("invalid blocks range: fromNonce and toNonce must be provided, with fromNonce <= toNonce")
+
+// ErrInvalidBlocksRoundRange signals that the provided blocks round range is invalid
+var ErrInvalidBlocksRoundRange = errors.New("invalid blocks range: fromRound and toRound must be provided, with fromRound <= toRound")
+
+// ErrInvalidLastEventID signals that the provided last event ID is not a valid hyperblock nonce
+var ErrInvalidLastEventID = errors.New("invalid Last-Event-ID header: it should hold the nonce of the last received hyperblock")
@@ -1,8 +1,11 @@
 package groups
 
 import (
+	"context"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -26,12 +29,24 @@ type AccountsFacadeHandler interface {
 	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	ReserveNonces(address string, count uint64) (*data.NonceReservation, error)
+	SyncNonceReservations(address string) (*data.NonceReservationSync, error)
+	GetAccountPortfolio(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error)
+	GetESDTBalancesForAccounts(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error)
+	GetBalanceHistory(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error)
+	GetAccountDiff(address string, options common.AccountDiffOptions) (*data.AccountDiff, error)
+	GetDecodedKeyValuePairs(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error)
+	GetDecodedValueForKey(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error)
+	GetUsernameForAddress(address string) (*data.AddressUsername, error)
+	StreamKeys(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error
 }
 
 // BlockFacadeHandler interface defines methods that can be used from the facade
 type BlockFacadeHandler interface {
 	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByTimestamp(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	StreamBlocks(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error
 	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 }
@@ -39,6 +54,7 @@ type BlockFacadeHandler interface {
 // BlocksFacadeHandler interface defines methods that can be used from the facade
 type BlocksFacadeHandler interface {
 	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	StreamBlocksByRound(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error
 }
 
 // InternalFacadeHandler interface defines methods that can be used from facade context variable
@@ -46,6 +62,7 @@ type InternalFacadeHandler interface {
 	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonce(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalMiniBlockByHashWithoutEpoch(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 }
@@ -54,6 +71,10 @@ type InternalFacadeHandler interface {
 type HyperBlockFacadeHandler interface {
 	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByTimestamp(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	DecodeHyperblockEvents(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents
+	StreamHyperBlocks(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	FollowHyperBlocks(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from the facade
@@ -71,6 +92,8 @@ type NetworkFacadeHandler interface {
 	GetGasConfigs() (*data.GenericAPIResponse, error)
 	GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
 	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetEpochBoundaries(epoch uint32) (*data.EpochBoundariesApiResponse, error)
+	GetEpochsBoundaries(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error)
 }
 
 // NodeFacadeHandler interface defines methods that can be used from the facade
@@ -91,6 +114,8 @@ type TransactionFacadeHandler interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	DryRunTransaction(tx *data.Transaction) (int, *data.TransactionDryRunResult, error)
+	DryRunMultipleTransactions(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error)
 	IsFaucetEnabled() bool
 	SendUserFunds(receiver string, value *big.Int) error
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
@@ -103,6 +128,9 @@ type TransactionFacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTransactionFeeBreakdown(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error)
+	GetTransactionCostFeeBreakdown(tx *data.Transaction, cost *data.TxCostResponseData) (*data.FeeBreakdown, error)
+	DecodeTransactionEvents(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents
 }
 
 // ProofFacadeHandler interface defines methods that can be used from the facade
@@ -135,3 +163,30 @@ type AboutFacadeHandler interface {
 	GetAboutInfo() (*data.GenericAPIResponse, error)
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// UtilsFacadeHandler defines the methods that can be used from the facade
+type UtilsFacadeHandler interface {
+	ConvertBech32AddressesToHex(addresses []string) []*data.AddressConversion
+	ConvertHexAddressesToBech32(addresses []string) []*data.AddressConversion
+	ComputeShardIDsForAddresses(addresses []string) []*data.AddressShardID
+	ValidateAddresses(addresses []string) []*data.AddressValidation
+	ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error)
+}
+
+// EventsFacadeHandler defines the methods that can be used from the facade
+type EventsFacadeHandler interface {
+	SearchEvents(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error)
+}
+
+// WebhooksFacadeHandler defines the methods that can be used from the facade
+type WebhooksFacadeHandler interface {
+	RegisterWebhook(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error)
+	GetWebhooks(owner string) []*data.WebhookSubscription
+	RemoveWebhook(owner string, id string) error
+	GetWebhookDeliveries(owner string, id string) ([]*data.WebhookDelivery, error)
+}
+
+// UsernameFacadeHandler defines the methods that can be used from the facade
+type UsernameFacadeHandler interface {
+	ResolveUsername(username string) (*data.UsernameResolution, error)
+}
@@ -2,11 +2,14 @@ package groups
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // SystemAccountAddressBech is the const for the system account address
@@ -48,6 +51,11 @@ func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions,
 		return common.HyperblockQueryOptions{}, err
 	}
 
+	allowPartial, err := parseBoolUrlParam(c, common.UrlParameterAllowPartial)
+	if err != nil {
+		return common.HyperblockQueryOptions{}, err
+	}
+
 	var alteredAccountsOptions common.GetAlteredAccountsForBlockOptions
 	if withAlteredAccounts {
 		alteredAccountsOptions, err = parseAlteredAccountOptions(c)
@@ -61,9 +69,33 @@ func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions,
 		NotarizedAtSource:      notarizedAtSource,
 		WithAlteredAccounts:    withAlteredAccounts,
 		AlteredAccountsOptions: alteredAccountsOptions,
+		Filters:                parseHyperblockFilters(c),
+		AllowPartial:           allowPartial,
 	}, nil
 }
 
+func parseHyperblockFilters(c *gin.Context) common.HyperblockFilters {
+	filters := common.HyperblockFilters{
+		Status: parseStringUrlParam(c, common.UrlParameterStatusFilter),
+	}
+
+	addresses := parseListUrlParam(c, common.UrlParameterAddressesFilter)
+	if len(addresses) > 0 {
+		filters.Addresses = addresses
+	}
+	// the wildcard, accepted by the altered accounts tokens filter, does not filter anything
+	tokens := parseListUrlParam(c, common.UrlParameterTokensFilter)
+	if len(tokens) > 0 && tokens[0] != "*" {
+		filters.Tokens = tokens
+	}
+	functions := parseListUrlParam(c, common.UrlParameterFunctionsFilter)
+	if len(functions) > 0 {
+		filters.Functions = functions
+	}
+
+	return filters
+}
+
 func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQueryOptions, error) {
 	onFinalBlock, err := parseBoolUrlParam(c, common.UrlParameterOnFinalBlock)
 	if err != nil {
@@ -123,6 +155,82 @@ func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQue
 	return options, nil
 }
 
+func parseBalanceHistoryOptions(c *gin.Context) (common.BalanceHistoryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return common.BalanceHistoryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return common.BalanceHistoryOptions{}, err
+	}
+
+	fromEpoch, err := parseUint32UrlParam(c, common.UrlParameterFromEpoch)
+	if err != nil {
+		return common.BalanceHistoryOptions{}, err
+	}
+
+	toEpoch, err := parseUint32UrlParam(c, common.UrlParameterToEpoch)
+	if err != nil {
+		return common.BalanceHistoryOptions{}, err
+	}
+
+	step, err := parseUint64UrlParam(c, common.UrlParameterStep)
+	if err != nil {
+		return common.BalanceHistoryOptions{}, err
+	}
+	if !step.HasValue {
+		step.Value = 1
+	}
+
+	options := common.BalanceHistoryOptions{
+		FromNonce: fromNonce,
+		ToNonce:   toNonce,
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+		Step:      step.Value,
+		Tokens:    parseListUrlParam(c, common.UrlParameterTokensFilter),
+	}
+
+	numPoints := options.NumPoints()
+	if numPoints == 0 {
+		return common.BalanceHistoryOptions{}, ErrInvalidBalanceHistoryRange
+	}
+	if numPoints > common.MaxBalanceHistoryPoints {
+		return common.BalanceHistoryOptions{}, fmt.Errorf("%w: %d requested, at most %d allowed", ErrTooManyBalanceHistoryPoints, numPoints, common.MaxBalanceHistoryPoints)
+	}
+
+	return options, nil
+}
+
+func parseAccountDiffOptions(c *gin.Context) (common.AccountDiffOptions, error) {
+	fromBlockNonce, err := parseUint64UrlParam(c, common.UrlParameterFromBlockNonce)
+	if err != nil {
+		return common.AccountDiffOptions{}, err
+	}
+
+	toBlockNonce, err := parseUint64UrlParam(c, common.UrlParameterToBlockNonce)
+	if err != nil {
+		return common.AccountDiffOptions{}, err
+	}
+
+	withKeys, err := parseBoolUrlParam(c, common.UrlParameterWithKeys)
+	if err != nil {
+		return common.AccountDiffOptions{}, err
+	}
+
+	if !fromBlockNonce.HasValue || !toBlockNonce.HasValue || fromBlockNonce.Value >= toBlockNonce.Value {
+		return common.AccountDiffOptions{}, ErrInvalidAccountDiffRange
+	}
+
+	return common.AccountDiffOptions{
+		FromBlockNonce: fromBlockNonce.Value,
+		ToBlockNonce:   toBlockNonce.Value,
+		WithKeys:       withKeys,
+	}, nil
+}
+
 func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOptions, error) {
 	withResults, err := parseBoolUrlParam(c, common.UrlParameterWithResults)
 	if err != nil {
@@ -160,6 +268,19 @@ func parseStringUrlParam(c *gin.Context, name string) string {
 	return c.Request.URL.Query().Get(name)
 }
 
+// parseListUrlParam returns the non-empty values of a comma separated URL parameter
+func parseListUrlParam(c *gin.Context, name string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(c.Request.URL.Query().Get(name), ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
 func parseUint32UrlParam(c *gin.Context, name string) (core.OptionalUint32, error) {
 	param := c.Request.URL.Query().Get(name)
 	if param == "" {
@@ -235,3 +356,86 @@ func parseAlteredAccountOptions(c *gin.Context) (common.GetAlteredAccountsForBlo
 		TokensFilter: tokensFilter,
 	}, nil
 }
+
+func parseEventsSearchOptions(c *gin.Context) (common.EventsSearchOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return common.EventsSearchOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return common.EventsSearchOptions{}, err
+	}
+
+	if !fromNonce.HasValue || !toNonce.HasValue || fromNonce.Value > toNonce.Value {
+		return common.EventsSearchOptions{}, ErrInvalidHyperblocksRange
+	}
+	if toNonce.Value-fromNonce.Value >= common.MaxHyperblocksRangeSize {
+		return common.EventsSearchOptions{}, fmt.Errorf("%w: at most %d hyperblocks can be searched at once", ErrInvalidHyperblocksRange, common.MaxHyperblocksRangeSize)
+	}
+
+	topics, err := parseTopicsUrlParams(c)
+	if err != nil {
+		return common.EventsSearchOptions{}, err
+	}
+
+	limit, err := parseUint64UrlParam(c, common.UrlParameterLimit)
+	if err != nil {
+		return common.EventsSearchOptions{}, err
+	}
+	if !limit.HasValue {
+		limit.Value = common.DefaultEventsSearchLimit
+	}
+	if limit.Value == 0 || limit.Value > common.MaxEventsSearchLimit {
+		return common.EventsSearchOptions{}, fmt.Errorf("%w: %s must be between 1 and %d", ErrInvalidEventsSearchLimit, common.UrlParameterLimit, common.MaxEventsSearchLimit)
+	}
+
+	options := common.EventsSearchOptions{
+		FromNonce:  fromNonce.Value,
+		ToNonce:    toNonce.Value,
+		Identifier: parseStringUrlParam(c, common.UrlParameterIdentifier),
+		Address:    parseStringUrlParam(c, common.UrlParameterAddress),
+		Topics:     topics,
+		Limit:      limit.Value,
+	}
+
+	resumeToken := parseStringUrlParam(c, common.UrlParameterResumeToken)
+	if len(resumeToken) == 0 {
+		return options, nil
+	}
+
+	resumeState, err := data.NewEventsSearchResumeStateFromToken(resumeToken)
+	if err != nil {
+		return common.EventsSearchOptions{}, err
+	}
+	if resumeState.Nonce < options.FromNonce || resumeState.Nonce > options.ToNonce {
+		return common.EventsSearchOptions{}, fmt.Errorf("%w: it does not belong to the requested range", data.ErrInvalidEventsSearchResumeToken)
+	}
+	options.FromNonce = resumeState.Nonce
+	options.FromEventIndex = resumeState.EventIndex
+
+	return options, nil
+}
+
+// parseTopicsUrlParams returns the topics filters, indexed by position. The positions without filter hold empty strings
+func parseTopicsUrlParams(c *gin.Context) ([]string, error) {
+	topics := make([]string, 0)
+	for name := range c.Request.URL.Query() {
+		if !strings.HasPrefix(name, common.UrlParameterTopicPrefix) {
+			continue
+		}
+
+		position, err := strconv.ParseUint(strings.TrimPrefix(name, common.UrlParameterTopicPrefix), 10, 32)
+		if err != nil || position >= common.MaxEventsSearchTopics {
+			return nil, fmt.Errorf("%w: %s, at most %d topics can be filtered, from %s0", ErrInvalidTopicFilter, name, common.MaxEventsSearchTopics, common.UrlParameterTopicPrefix)
+		}
+
+		for uint64(len(topics)) <= position {
+			topics = append(topics, "")
+		}
+		topics[position] = parseStringUrlParam(c, name)
+	}
+
+	return topics, nil
+}
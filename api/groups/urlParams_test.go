@@ -124,6 +124,27 @@ func TestParseHyperblockQueryOptions(t *testing.T) {
 			},
 		}, options)
 	})
+
+	t.Run("with filters", func(t *testing.T) {
+		t.Parallel()
+
+		query := fmt.Sprintf("%s=erd1alice,erd1bob&%s=MEX-abcdef&%s=ESDTTransfer&%s=success",
+			common.UrlParameterAddressesFilter,
+			common.UrlParameterTokensFilter,
+			common.UrlParameterFunctionsFilter,
+			common.UrlParameterStatusFilter,
+		)
+		options, err := parseHyperblockQueryOptions(createDummyGinContextWithQuery(query))
+		require.Nil(t, err)
+		require.Equal(t, common.HyperblockQueryOptions{
+			Filters: common.HyperblockFilters{
+				Addresses: []string{"erd1alice", "erd1bob"},
+				Tokens:    []string{"MEX-abcdef"},
+				Functions: []string{"ESDTTransfer"},
+				Status:    "success",
+			},
+		}, options)
+	})
 }
 
 func TestParseAccountQueryOptions(t *testing.T) {
@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"bytes"
 	"net/http"
 	"time"
 
@@ -31,9 +30,6 @@ func (mm *metricsMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		t := time.Now()
 
-		bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
-		c.Writer = bw
-
 		c.Next()
 
 		duration := time.Since(t)
@@ -20,6 +20,10 @@ const (
 	prefixBadRequest           = "[bad request]"
 	prefixInternalError        = "[internal error]"
 	maxLengthRequestOrResponse = 400
+
+	// maxCapturedResponseLength is one byte above the logged length, so that a longer response is still marked as
+	// truncated. The streaming endpoints write unbounded responses, so the rest is never held in memory
+	maxCapturedResponseLength = maxLengthRequestOrResponse + 1
 )
 
 // TODO: remove this file and use the same middleware from mx-chain-go after it is merged
@@ -53,7 +57,7 @@ func (rlm *responseLoggerMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
 		c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
 		requestBodyString := string(bodyBytes)
 
-		bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+		bw := &bodyWriter{body: bytes.NewBufferString(""), limit: maxCapturedResponseLength, ResponseWriter: c.Writer}
 		c.Writer = bw
 
 		c.Next()
@@ -123,10 +127,19 @@ func prepareLog(str string) string {
 
 type bodyWriter struct {
 	gin.ResponseWriter
-	body *bytes.Buffer
+	body  *bytes.Buffer
+	limit int
 }
 
 func (w bodyWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	remaining := w.limit - w.body.Len()
+	if remaining > 0 {
+		if len(b) > remaining {
+			w.body.Write(b[:remaining])
+		} else {
+			w.body.Write(b)
+		}
+	}
+
 	return w.ResponseWriter.Write(b)
 }
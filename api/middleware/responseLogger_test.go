@@ -163,3 +163,37 @@ func TestResponseLoggerMiddleware_ShouldNotCallHandler(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.False(t, handlerWasCalled)
 }
+
+func TestResponseLoggerMiddleware_LongResponseShouldBeCappedInLog(t *testing.T) {
+	t.Parallel()
+
+	longBalance := strings.Repeat("7", 10*maxLengthRequestOrResponse)
+	facade := mock.FacadeStub{
+		GetAccountHandler: func(_ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account: data.Account{
+					Balance: longBalance,
+				},
+			}, nil
+		},
+	}
+
+	rlf := responseLogFields{}
+	printHandler := func(title string, path string, duration time.Duration, status int, clientIP string, request string, response string) {
+		rlf.response = response
+	}
+
+	rlm := NewResponseLoggerMiddleware(0)
+	rlm.printRequestFunc = printHandler
+
+	ws := startApiServerResponseLogger(&facade, rlm)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.Contains(resp.Body.String(), longBalance))
+	assert.Equal(t, maxLengthRequestOrResponse+len("..."), len(rlf.response))
+	assert.True(t, strings.HasSuffix(rlf.response, "..."))
+}
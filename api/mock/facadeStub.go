@@ -1,9 +1,12 @@
 package mock
 
 import (
+	"context"
 	"math/big"
+	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -56,6 +59,7 @@ type FacadeStub struct {
 	GetInternalBlockByHashCalled                 func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled                func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHashCalled             func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalMiniBlockByHashWithoutEpochCalled func(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlockCalled       func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
@@ -84,6 +88,41 @@ type FacadeStub struct {
 	IsDataTrieMigratedCalled                     func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeysCalled                            func(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetWaitingEpochsLeftForPublicKeyCalled       func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
+	GetTransactionFeeBreakdownCalled             func(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error)
+	GetTransactionCostFeeBreakdownCalled         func(tx *data.Transaction, cost *data.TxCostResponseData) (*data.FeeBreakdown, error)
+	DryRunTransactionCalled                      func(tx *data.Transaction) (int, *data.TransactionDryRunResult, error)
+	DryRunMultipleTransactionsCalled             func(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error)
+	ReserveNoncesCalled                          func(address string, count uint64) (*data.NonceReservation, error)
+	SyncNonceReservationsCalled                  func(address string) (*data.NonceReservationSync, error)
+	GetAccountPortfolioCalled                    func(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error)
+	GetESDTBalancesForAccountsCalled             func(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error)
+	GetBalanceHistoryCalled                      func(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error)
+	GetAccountDiffCalled                         func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error)
+	StreamKeysCalled                             func(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error
+	GetDecodedKeyValuePairsCalled                func(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error)
+	GetDecodedValueForKeyCalled                  func(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error)
+	ConvertBech32AddressesToHexCalled            func(addresses []string) []*data.AddressConversion
+	ConvertHexAddressesToBech32Called            func(addresses []string) []*data.AddressConversion
+	ComputeShardIDsForAddressesCalled            func(addresses []string) []*data.AddressShardID
+	ValidateAddressesCalled                      func(addresses []string) []*data.AddressValidation
+	ComputeContractAddressCalled                 func(deployer string, nonce uint64) (*data.ContractAddress, error)
+	ResolveUsernameCalled                        func(username string) (*data.UsernameResolution, error)
+	GetUsernameForAddressCalled                  func(address string) (*data.AddressUsername, error)
+	StreamHyperBlocksCalled                      func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	FollowHyperBlocksCalled                      func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	GetBlockByTimestampCalled                    func(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	StreamBlocksCalled                           func(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error
+	StreamBlocksByRoundCalled                    func(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error
+	GetHyperBlockByTimestampCalled               func(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetEpochBoundariesCalled                     func(epoch uint32) (*data.EpochBoundariesApiResponse, error)
+	GetEpochsBoundariesCalled                    func(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error)
+	SearchEventsCalled                           func(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error)
+	DecodeTransactionEventsCalled                func(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents
+	DecodeHyperblockEventsCalled                 func(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents
+	RegisterWebhookCalled                        func(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error)
+	GetWebhooksCalled                            func(owner string) []*data.WebhookSubscription
+	RemoveWebhookCalled                          func(owner string, id string) error
+	GetWebhookDeliveriesCalled                   func(owner string, id string) ([]*data.WebhookDelivery, error)
 }
 
 // GetProof -
@@ -408,6 +447,42 @@ func (f *FacadeStub) GetAddressConverter() (core.PubkeyConverter, error) {
 	return nil, nil
 }
 
+// DryRunTransaction -
+func (f *FacadeStub) DryRunTransaction(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+	if f.DryRunTransactionCalled != nil {
+		return f.DryRunTransactionCalled(tx)
+	}
+
+	return http.StatusOK, &data.TransactionDryRunResult{}, nil
+}
+
+// DryRunMultipleTransactions -
+func (f *FacadeStub) DryRunMultipleTransactions(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error) {
+	if f.DryRunMultipleTransactionsCalled != nil {
+		return f.DryRunMultipleTransactionsCalled(txs)
+	}
+
+	return &data.MultipleTransactionsDryRunResponseData{}, nil
+}
+
+// ReserveNonces -
+func (f *FacadeStub) ReserveNonces(address string, count uint64) (*data.NonceReservation, error) {
+	if f.ReserveNoncesCalled != nil {
+		return f.ReserveNoncesCalled(address, count)
+	}
+
+	return &data.NonceReservation{}, nil
+}
+
+// SyncNonceReservations -
+func (f *FacadeStub) SyncNonceReservations(address string) (*data.NonceReservationSync, error) {
+	if f.SyncNonceReservationsCalled != nil {
+		return f.SyncNonceReservationsCalled(address)
+	}
+
+	return &data.NonceReservationSync{}, nil
+}
+
 // SendMultipleTransactions -
 func (f *FacadeStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return f.SendMultipleTransactionsHandler(txs)
@@ -461,6 +536,22 @@ func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOpt
 	return nil, nil
 }
 
+// StreamBlocksByRound -
+func (f *FacadeStub) StreamBlocksByRound(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error {
+	if f.StreamBlocksByRoundCalled != nil {
+		return f.StreamBlocksByRoundCalled(fromRound, toRound, options, handler)
+	}
+	return nil
+}
+
+// StreamBlocks -
+func (f *FacadeStub) StreamBlocks(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error {
+	if f.StreamBlocksCalled != nil {
+		return f.StreamBlocksCalled(shardID, fromNonce, toNonce, options, handler)
+	}
+	return nil
+}
+
 // GetInternalBlockByHash -
 func (f *FacadeStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByHashCalled(shardID, hash, format)
@@ -471,6 +562,15 @@ func (f *FacadeStub) GetInternalBlockByNonce(shardID uint32, nonce uint64, forma
 	return f.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
+// GetInternalMiniBlockByHashWithoutEpoch -
+func (f *FacadeStub) GetInternalMiniBlockByHashWithoutEpoch(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	if f.GetInternalMiniBlockByHashWithoutEpochCalled != nil {
+		return f.GetInternalMiniBlockByHashWithoutEpochCalled(shardID, hash, hints, format)
+	}
+
+	return &data.InternalMiniBlockApiResponse{}, nil
+}
+
 // GetInternalMiniBlockByHash -
 func (f *FacadeStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return f.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
@@ -491,6 +591,51 @@ func (f *FacadeStub) GetHyperBlockByNonce(nonce uint64, options common.Hyperbloc
 	return f.GetHyperBlockByNonceCalled(nonce, options)
 }
 
+// GetBlockByTimestamp -
+func (f *FacadeStub) GetBlockByTimestamp(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if f.GetBlockByTimestampCalled != nil {
+		return f.GetBlockByTimestampCalled(shardID, timestamp, options)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
+
+// GetHyperBlockByTimestamp -
+func (f *FacadeStub) GetHyperBlockByTimestamp(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if f.GetHyperBlockByTimestampCalled != nil {
+		return f.GetHyperBlockByTimestampCalled(timestamp, options)
+	}
+
+	return &data.HyperblockApiResponse{}, nil
+}
+
+// DecodeHyperblockEvents -
+func (f *FacadeStub) DecodeHyperblockEvents(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents {
+	if f.DecodeHyperblockEventsCalled != nil {
+		return f.DecodeHyperblockEventsCalled(hyperblock)
+	}
+
+	return &data.HyperblockWithDecodedEvents{Hyperblock: hyperblock}
+}
+
+// StreamHyperBlocks -
+func (f *FacadeStub) StreamHyperBlocks(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+	if f.StreamHyperBlocksCalled != nil {
+		return f.StreamHyperBlocksCalled(fromNonce, toNonce, options, handler)
+	}
+
+	return nil
+}
+
+// FollowHyperBlocks -
+func (f *FacadeStub) FollowHyperBlocks(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+	if f.FollowHyperBlocksCalled != nil {
+		return f.FollowHyperBlocksCalled(ctx, fromNonce, options, handler)
+	}
+
+	return nil
+}
+
 // GetMetrics -
 func (f *FacadeStub) GetMetrics() map[string]*data.EndpointMetrics {
 	return f.GetMetricsCalled()
@@ -546,6 +691,33 @@ func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPI
 	return &data.TrieStatisticsAPIResponse{}, nil
 }
 
+// GetEpochBoundaries -
+func (f *FacadeStub) GetEpochBoundaries(epoch uint32) (*data.EpochBoundariesApiResponse, error) {
+	if f.GetEpochBoundariesCalled != nil {
+		return f.GetEpochBoundariesCalled(epoch)
+	}
+
+	return &data.EpochBoundariesApiResponse{}, nil
+}
+
+// GetEpochsBoundaries -
+func (f *FacadeStub) GetEpochsBoundaries(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error) {
+	if f.GetEpochsBoundariesCalled != nil {
+		return f.GetEpochsBoundariesCalled(fromEpoch, toEpoch)
+	}
+
+	return &data.EpochsBoundariesApiResponse{}, nil
+}
+
+// SearchEvents -
+func (f *FacadeStub) SearchEvents(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+	if f.SearchEventsCalled != nil {
+		return f.SearchEventsCalled(options)
+	}
+
+	return &data.EventsSearchApiResponse{}, nil
+}
+
 // GetEpochStartData -
 func (f *FacadeStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	return f.GetEpochStartDataCalled(epoch, shardID)
@@ -579,6 +751,69 @@ func (f *FacadeStub) IterateKeys(address string, numKeys uint, iteratorState [][
 	return &data.GenericAPIResponse{}, nil
 }
 
+// GetAccountPortfolio -
+func (f *FacadeStub) GetAccountPortfolio(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error) {
+	if f.GetAccountPortfolioCalled != nil {
+		return f.GetAccountPortfolioCalled(address, options)
+	}
+
+	return &data.AccountPortfolio{}, nil
+}
+
+// GetESDTBalancesForAccounts -
+func (f *FacadeStub) GetESDTBalancesForAccounts(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error) {
+	if f.GetESDTBalancesForAccountsCalled != nil {
+		return f.GetESDTBalancesForAccountsCalled(addresses, tokens, options)
+	}
+
+	return &data.AccountsESDTBalances{}, nil
+}
+
+// GetBalanceHistory -
+func (f *FacadeStub) GetBalanceHistory(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error) {
+	if f.GetBalanceHistoryCalled != nil {
+		return f.GetBalanceHistoryCalled(address, options)
+	}
+
+	return &data.AccountBalanceHistory{}, nil
+}
+
+// GetAccountDiff -
+func (f *FacadeStub) GetAccountDiff(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	if f.GetAccountDiffCalled != nil {
+		return f.GetAccountDiffCalled(address, options)
+	}
+
+	return &data.AccountDiff{}, nil
+}
+
+// GetDecodedKeyValuePairs -
+func (f *FacadeStub) GetDecodedKeyValuePairs(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error) {
+	if f.GetDecodedKeyValuePairsCalled != nil {
+		return f.GetDecodedKeyValuePairsCalled(address, options)
+	}
+
+	return &data.DecodedKeyValuePairs{}, nil
+}
+
+// GetDecodedValueForKey -
+func (f *FacadeStub) GetDecodedValueForKey(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error) {
+	if f.GetDecodedValueForKeyCalled != nil {
+		return f.GetDecodedValueForKeyCalled(address, key, options)
+	}
+
+	return &data.DecodedStorageEntry{}, nil
+}
+
+// StreamKeys -
+func (f *FacadeStub) StreamKeys(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error {
+	if f.StreamKeysCalled != nil {
+		return f.StreamKeysCalled(address, numKeysPerPage, resumeState, options, handler)
+	}
+
+	return nil
+}
+
 // GetWaitingEpochsLeftForPublicKey -
 func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if f.GetWaitingEpochsLeftForPublicKeyCalled != nil {
@@ -587,6 +822,132 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.W
 	return &data.WaitingEpochsLeftApiResponse{}, nil
 }
 
+// GetTransactionFeeBreakdown -
+func (f *FacadeStub) GetTransactionFeeBreakdown(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error) {
+	if f.GetTransactionFeeBreakdownCalled != nil {
+		return f.GetTransactionFeeBreakdownCalled(tx)
+	}
+
+	return &data.FeeBreakdown{}, nil
+}
+
+// DecodeTransactionEvents -
+func (f *FacadeStub) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents {
+	if f.DecodeTransactionEventsCalled != nil {
+		return f.DecodeTransactionEventsCalled(tx)
+	}
+
+	return &data.TransactionWithDecodedEvents{ApiTransactionResult: tx}
+}
+
+// GetTransactionCostFeeBreakdown -
+func (f *FacadeStub) GetTransactionCostFeeBreakdown(tx *data.Transaction, cost *data.TxCostResponseData) (*data.FeeBreakdown, error) {
+	if f.GetTransactionCostFeeBreakdownCalled != nil {
+		return f.GetTransactionCostFeeBreakdownCalled(tx, cost)
+	}
+
+	return &data.FeeBreakdown{}, nil
+}
+
 // WrongFacade is a struct that can be used as a wrong implementation of the node router handler
 type WrongFacade struct {
 }
+
+// ConvertBech32AddressesToHex -
+func (f *FacadeStub) ConvertBech32AddressesToHex(addresses []string) []*data.AddressConversion {
+	if f.ConvertBech32AddressesToHexCalled != nil {
+		return f.ConvertBech32AddressesToHexCalled(addresses)
+	}
+
+	return make([]*data.AddressConversion, 0)
+}
+
+// ConvertHexAddressesToBech32 -
+func (f *FacadeStub) ConvertHexAddressesToBech32(addresses []string) []*data.AddressConversion {
+	if f.ConvertHexAddressesToBech32Called != nil {
+		return f.ConvertHexAddressesToBech32Called(addresses)
+	}
+
+	return make([]*data.AddressConversion, 0)
+}
+
+// ComputeShardIDsForAddresses -
+func (f *FacadeStub) ComputeShardIDsForAddresses(addresses []string) []*data.AddressShardID {
+	if f.ComputeShardIDsForAddressesCalled != nil {
+		return f.ComputeShardIDsForAddressesCalled(addresses)
+	}
+
+	return make([]*data.AddressShardID, 0)
+}
+
+// ValidateAddresses -
+func (f *FacadeStub) ValidateAddresses(addresses []string) []*data.AddressValidation {
+	if f.ValidateAddressesCalled != nil {
+		return f.ValidateAddressesCalled(addresses)
+	}
+
+	return make([]*data.AddressValidation, 0)
+}
+
+// ComputeContractAddress -
+func (f *FacadeStub) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	if f.ComputeContractAddressCalled != nil {
+		return f.ComputeContractAddressCalled(deployer, nonce)
+	}
+
+	return &data.ContractAddress{}, nil
+}
+
+// ResolveUsername -
+func (f *FacadeStub) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	if f.ResolveUsernameCalled != nil {
+		return f.ResolveUsernameCalled(username)
+	}
+
+	return &data.UsernameResolution{}, nil
+}
+
+// GetUsernameForAddress -
+func (f *FacadeStub) GetUsernameForAddress(address string) (*data.AddressUsername, error) {
+	if f.GetUsernameForAddressCalled != nil {
+		return f.GetUsernameForAddressCalled(address)
+	}
+
+	return &data.AddressUsername{}, nil
+}
+
+// RegisterWebhook -
+func (f *FacadeStub) RegisterWebhook(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+	if f.RegisterWebhookCalled != nil {
+		return f.RegisterWebhookCalled(owner, request)
+	}
+
+	return &data.WebhookSubscription{}, nil
+}
+
+// GetWebhooks -
+func (f *FacadeStub) GetWebhooks(owner string) []*data.WebhookSubscription {
+	if f.GetWebhooksCalled != nil {
+		return f.GetWebhooksCalled(owner)
+	}
+
+	return make([]*data.WebhookSubscription, 0)
+}
+
+// RemoveWebhook -
+func (f *FacadeStub) RemoveWebhook(owner string, id string) error {
+	if f.RemoveWebhookCalled != nil {
+		return f.RemoveWebhookCalled(owner, id)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries -
+func (f *FacadeStub) GetWebhookDeliveries(owner string, id string) ([]*data.WebhookDelivery, error) {
+	if f.GetWebhookDeliveriesCalled != nil {
+		return f.GetWebhookDeliveriesCalled(owner, id)
+	}
+
+	return make([]*data.WebhookDelivery, 0), nil
+}
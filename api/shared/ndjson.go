@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NDJSONContentType is the content type of the newline delimited JSON streams
+const NDJSONContentType = "application/x-ndjson"
+
+// NDJSONStreamWriter writes newline delimited JSON lines to the response. The stream, with its status code and
+// content type, starts with the first written line, so that errors occurring before it can still be reported as
+// regular API responses
+type NDJSONStreamWriter struct {
+	c       *gin.Context
+	encoder *json.Encoder
+	started bool
+}
+
+// NewNDJSONStreamWriter returns a new instance of NDJSONStreamWriter
+func NewNDJSONStreamWriter(c *gin.Context) *NDJSONStreamWriter {
+	return &NDJSONStreamWriter{
+		c:       c,
+		encoder: json.NewEncoder(c.Writer),
+	}
+}
+
+// WriteLine writes the provided value as one JSON line. It errors if the client has gone away
+func (writer *NDJSONStreamWriter) WriteLine(line interface{}) error {
+	err := writer.c.Request.Context().Err()
+	if err != nil {
+		return err
+	}
+
+	if !writer.started {
+		writer.c.Header("Content-Type", NDJSONContentType)
+		writer.c.Status(http.StatusOK)
+		writer.started = true
+	}
+
+	return writer.encoder.Encode(line)
+}
+
+// Flush sends the buffered lines to the client
+func (writer *NDJSONStreamWriter) Flush() {
+	writer.c.Writer.Flush()
+}
+
+// IsStarted returns true if at least one line has been written
+func (writer *NDJSONStreamWriter) IsStarted() bool {
+	return writer.started
+}
@@ -33,6 +33,16 @@ func FetchNonceFromRequest(c *gin.Context) (uint64, error) {
 	return strconv.ParseUint(nonceStr, 10, 64)
 }
 
+// FetchTimestampFromRequest will try to fetch the Unix timestamp from the request
+func FetchTimestampFromRequest(c *gin.Context) (uint64, error) {
+	timestampStr := c.Param("timestamp")
+	if timestampStr == "" {
+		return 0, errors.ErrInvalidTimestampParam
+	}
+
+	return strconv.ParseUint(timestampStr, 10, 64)
+}
+
 // FetchRoundFromRequest will try to fetch the round from the request
 func FetchRoundFromRequest(c *gin.Context) (uint64, error) {
 	roundStr := c.Param("round")
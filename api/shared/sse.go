@@ -0,0 +1,73 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// SSEContentType is the content type of the server-sent events streams
+	SSEContentType = "text/event-stream"
+	// LastEventIDHeader is the header through which a reconnecting client provides the ID of the last received event
+	LastEventIDHeader = "Last-Event-ID"
+)
+
+// SSEStreamWriter writes server-sent events to the response. As for the NDJSON streams, the stream starts with the
+// first written event, so that errors occurring before it can still be reported as regular API responses
+type SSEStreamWriter struct {
+	c       *gin.Context
+	started bool
+}
+
+// NewSSEStreamWriter returns a new instance of SSEStreamWriter
+func NewSSEStreamWriter(c *gin.Context) *SSEStreamWriter {
+	return &SSEStreamWriter{
+		c: c,
+	}
+}
+
+// WriteEvent writes one event, holding the JSON representation of the provided data, and sends it to the client.
+// The ID is omitted if empty. It errors if the client has gone away
+func (writer *SSEStreamWriter) WriteEvent(id string, event string, data interface{}) error {
+	err := writer.c.Request.Context().Err()
+	if err != nil {
+		return err
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if !writer.started {
+		writer.c.Header("Content-Type", SSEContentType)
+		writer.c.Header("Cache-Control", "no-cache")
+		writer.c.Header("Connection", "keep-alive")
+		writer.c.Header("X-Accel-Buffering", "no")
+		writer.c.Status(http.StatusOK)
+		writer.started = true
+	}
+
+	if len(id) > 0 {
+		_, err = fmt.Fprintf(writer.c.Writer, "id: %s\n", id)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(writer.c.Writer, "event: %s\ndata: %s\n\n", event, dataBytes)
+	if err != nil {
+		return err
+	}
+
+	writer.c.Writer.Flush()
+	return nil
+}
+
+// IsStarted returns true if at least one event has been written
+func (writer *SSEStreamWriter) IsStarted() bool {
+	return writer.started
+}
@@ -23,10 +23,12 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/metrics"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
@@ -39,6 +41,10 @@ const (
 	logFileLifeSpanInSec = 86400
 	logFileMaxSizeInMB   = 1024
 	addressHRP           = "erd"
+
+	defaultUsernamesCacheValidityDurationSec  = 300
+	defaultHyperblocksStreamPollingIntervalMs = 1000
+	defaultBlocksCacheCapacity                = 10000
 )
 
 // commitID and appVersion should be populated at build time using ldflags
@@ -308,9 +314,32 @@ func loadMainConfig(filepath string) (*config.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	applyMissingConfigDefaults(cfg)
+
 	return cfg, nil
 }
 
+// applyMissingConfigDefaults fills in the settings added after older config files were written, so that those
+// files keep working without changes
+func applyMissingConfigDefaults(cfg *config.Config) {
+	if cfg.GeneralSettings.UsernamesCacheValidityDurationSec == 0 {
+		log.Warn("missing UsernamesCacheValidityDurationSec in config, using the default value",
+			"value", defaultUsernamesCacheValidityDurationSec)
+		cfg.GeneralSettings.UsernamesCacheValidityDurationSec = defaultUsernamesCacheValidityDurationSec
+	}
+	if cfg.GeneralSettings.HyperblocksStreamPollingIntervalMs == 0 {
+		log.Warn("missing HyperblocksStreamPollingIntervalMs in config, using the default value",
+			"value", defaultHyperblocksStreamPollingIntervalMs)
+		cfg.GeneralSettings.HyperblocksStreamPollingIntervalMs = defaultHyperblocksStreamPollingIntervalMs
+	}
+	if cfg.BlocksCache.Capacity == 0 {
+		log.Warn("missing BlocksCache.Capacity in config, using the default value",
+			"value", defaultBlocksCacheCapacity)
+		cfg.BlocksCache.Capacity = defaultBlocksCacheCapacity
+	}
+}
+
 func createVersionsRegistryTestOrProduction(
 	ctx *cli.Context,
 	cfg *config.Config,
@@ -336,12 +365,17 @@ func createVersionsRegistryTestOrProduction(
 				HeartbeatCacheValidityDurationSec:        60,
 				ValStatsCacheValidityDurationSec:         60,
 				EconomicsMetricsCacheValidityDurationSec: 6,
+				UsernamesCacheValidityDurationSec:        60,
+				HyperblocksStreamPollingIntervalMs:       1000,
 				FaucetValue:                              "10000000000",
 			},
 			ApiLogging: config.ApiLoggingConfig{
 				LoggingEnabled:          true,
 				ThresholdInMicroSeconds: 10000,
 			},
+			BlocksCache: config.BlocksCacheConfig{
+				Capacity: 1000,
+			},
 			Observers: []*data.NodeData{
 				{
 					ShardId: 0,
@@ -518,11 +552,28 @@ func createVersionsRegistry(
 	valStatsProc.StartCacheUpdate()
 	nodeStatusProc.StartCacheUpdate()
 
-	blockProc, err := process.NewBlockProcessor(bp)
+	blocksCache, err := createBlocksCache(bp, cfg.BlocksCache)
 	if err != nil {
 		return nil, err
 	}
 
+	blockProc, err := process.NewBlockProcessor(bp, blocksCache)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.GeneralSettings.VerifyRawInternalBlocks {
+		internalBlocksVerifier, err := process.NewInternalBlocksVerifier(marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		err = blockProc.SetInternalBlocksVerifier(internalBlocksVerifier)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	blocksPrc, err := process.NewBlocksProcessor(bp)
 	if err != nil {
 		return nil, err
@@ -548,6 +599,23 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	cacheValidity = time.Duration(cfg.GeneralSettings.UsernamesCacheValidityDurationSec) * time.Second
+	usernameProc, err := process.NewUsernameProcessor(bp, scQueryProc, pubKeyConverter, cacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	pollingInterval := time.Duration(cfg.GeneralSettings.HyperblocksStreamPollingIntervalMs) * time.Millisecond
+	hyperblocksFollower, err := process.NewHyperblocksFollower(blockProc, nodeStatusProc, pollingInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooksProc, err := createWebhooksProcessor(cfg.Webhooks, bp, blockProc, nodeStatusProc, pollingInterval, pubKeyConverter, closableComponents)
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
 		ActionsProcessor:             bp,
 		AccountProcessor:             accntProc,
@@ -564,6 +632,9 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		UsernameProcessor:            usernameProc,
+		HyperblocksFollower:          hyperblocksFollower,
+		WebhooksProcessor:            webhooksProc,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
@@ -574,6 +645,74 @@ func createVersionsRegistry(
 	return versionsFactory.CreateVersionsRegistry(facadeArgs, apiConfigParser)
 }
 
+func createWebhooksProcessor(
+	cfg config.WebhooksConfig,
+	proc process.Processor,
+	hyperblocksStreamer process.HyperblocksRangeStreamer,
+	latestNonceProvider process.LatestHyperblockNonceProvider,
+	pollingInterval time.Duration,
+	pubKeyConverter core.PubkeyConverter,
+	closableComponents *data.ClosableComponentsHandler,
+) (facade.WebhooksProcessor, error) {
+	if !cfg.Enabled {
+		return &disabled.WebhooksProcessor{}, nil
+	}
+
+	// the webhooks are notified only with the hyperblocks that can no longer be reverted
+	finalNonceProvider, err := process.NewFinalHyperblockNonceProvider(proc, latestNonceProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	finalHyperblocksFollower, err := process.NewHyperblocksFollower(hyperblocksStreamer, finalNonceProvider, pollingInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := process.NewWebhooksStore(cfg.StorePath)
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout := time.Duration(cfg.RequestTimeoutSec) * time.Second
+	args := process.ArgWebhooksProcessor{
+		HyperblocksFollower:      finalHyperblocksFollower,
+		Store:                    store,
+		HttpClient:               process.NewWebhooksHttpClient(requestTimeout, cfg.AllowPrivateDestinations),
+		PubKeyConverter:          pubKeyConverter,
+		MaxSubscriptions:         cfg.MaxSubscriptions,
+		MaxRetries:               cfg.MaxRetries,
+		InitialBackoff:           time.Duration(cfg.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:               time.Duration(cfg.MaxBackoffMs) * time.Millisecond,
+		DeliveryLogSize:          cfg.DeliveryLogSize,
+		AllowPrivateDestinations: cfg.AllowPrivateDestinations,
+	}
+	webhooksProc, err := process.NewWebhooksProcessor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	closableComponents.Add(webhooksProc)
+	webhooksProc.StartNotifications()
+
+	return webhooksProc, nil
+}
+
+func createBlocksCache(proc process.Processor, cfg config.BlocksCacheConfig) (process.BlocksCacheHandler, error) {
+	var cacher process.ImmutableDataCacheHandler
+	var err error
+	if cfg.SpillToDisk {
+		cacher, err = cache.NewDiskSpillCache(cfg.Capacity, cfg.SpillCapacity, cfg.SpillDirectory)
+	} else {
+		cacher, err = cache.NewLRUCache(cfg.Capacity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return process.NewBlocksCache(proc, cacher)
+}
+
 func startWebServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	generalConfig *config.Config,
@@ -16,3 +16,36 @@ const (
 	// Proto output format returns the bytes of the proto object
 	Proto OutputFormat = 1
 )
+
+// MaxNonceReservationCount defines the maximum number of nonces that can be reserved at once for a sender
+const MaxNonceReservationCount = 1000
+
+// MaxBalanceHistoryPoints defines the maximum number of points that can be requested in a balance history query
+const MaxBalanceHistoryPoints = 500
+
+// DefaultKeysStreamPageSize defines the number of keys fetched at once from the observers while streaming the keys of an account
+const DefaultKeysStreamPageSize = 1000
+
+// MaxKeysStreamPageSize defines the maximum number of keys that can be fetched at once while streaming the keys of an account
+const MaxKeysStreamPageSize = 10000
+
+// MaxUtilsBulkAddresses defines the maximum number of addresses that can be handled at once by the utils endpoints
+const MaxUtilsBulkAddresses = 1000
+
+// MaxEpochsRangeSize defines the maximum number of epochs whose boundaries can be requested at once
+const MaxEpochsRangeSize = 20
+
+// MaxEventsSearchTopics defines the maximum number of topics an events search can be filtered by
+const MaxEventsSearchTopics = 4
+
+// DefaultEventsSearchLimit defines the number of events returned at once by an events search, when not provided
+const DefaultEventsSearchLimit = 100
+
+// MaxEventsSearchLimit defines the maximum number of events that can be returned at once by an events search
+const MaxEventsSearchLimit = 1000
+
+// MaxHyperblocksRangeSize defines the maximum number of hyperblocks that can be requested at once in a range
+const MaxHyperblocksRangeSize = 100
+
+// MaxBlocksRangeSize defines the maximum number of nonces or rounds whose blocks can be requested at once in a range
+const MaxBlocksRangeSize = 100
@@ -52,6 +52,58 @@ const (
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterWithKeys represents the name of an URL parameter
 	UrlParameterWithKeys = "withKeys"
+	// UrlParameterWithFeeBreakdown represents the name of an URL parameter
+	UrlParameterWithFeeBreakdown = "withFeeBreakdown"
+	// UrlParameterWithDecodedEvents represents the name of an URL parameter
+	UrlParameterWithDecodedEvents = "withDecodedEvents"
+	// UrlParameterTxHash represents the name of an URL parameter
+	UrlParameterTxHash = "txHash"
+	// UrlParameterAllowPartial represents the name of an URL parameter
+	UrlParameterAllowPartial = "allowPartial"
+	// UrlParameterDryRun represents the name of an URL parameter
+	UrlParameterDryRun = "dryRun"
+	// UrlParameterCount represents the name of an URL parameter
+	UrlParameterCount = "count"
+	// UrlParameterFromNonce represents the name of an URL parameter
+	UrlParameterFromNonce = "fromNonce"
+	// UrlParameterToNonce represents the name of an URL parameter
+	UrlParameterToNonce = "toNonce"
+	// UrlParameterFromRound represents the name of an URL parameter
+	UrlParameterFromRound = "fromRound"
+	// UrlParameterToRound represents the name of an URL parameter
+	UrlParameterToRound = "toRound"
+	// UrlParameterFromEpoch represents the name of an URL parameter
+	UrlParameterFromEpoch = "fromEpoch"
+	// UrlParameterToEpoch represents the name of an URL parameter
+	UrlParameterToEpoch = "toEpoch"
+	// UrlParameterStep represents the name of an URL parameter
+	UrlParameterStep = "step"
+	// UrlParameterFromBlockNonce represents the name of an URL parameter
+	UrlParameterFromBlockNonce = "fromBlockNonce"
+	// UrlParameterToBlockNonce represents the name of an URL parameter
+	UrlParameterToBlockNonce = "toBlockNonce"
+	// UrlParameterNumKeys represents the name of an URL parameter
+	UrlParameterNumKeys = "numKeys"
+	// UrlParameterResumeToken represents the name of an URL parameter
+	UrlParameterResumeToken = "resumeToken"
+	// UrlParameterDecode represents the name of an URL parameter
+	UrlParameterDecode = "decode"
+	// UrlParameterVerify represents the name of an URL parameter
+	UrlParameterVerify = "verify"
+	// UrlParameterAddressesFilter represents the name of an URL parameter
+	UrlParameterAddressesFilter = "addresses"
+	// UrlParameterFunctionsFilter represents the name of an URL parameter
+	UrlParameterFunctionsFilter = "functions"
+	// UrlParameterStatusFilter represents the name of an URL parameter
+	UrlParameterStatusFilter = "status"
+	// UrlParameterIdentifier represents the name of an URL parameter
+	UrlParameterIdentifier = "identifier"
+	// UrlParameterAddress represents the name of an URL parameter
+	UrlParameterAddress = "address"
+	// UrlParameterTopicPrefix represents the prefix of the URL parameters holding the topics, as topic0, topic1 and so on
+	UrlParameterTopicPrefix = "topic"
+	// UrlParameterLimit represents the name of an URL parameter
+	UrlParameterLimit = "limit"
 )
 
 // BlockQueryOptions holds options for block queries
@@ -67,6 +119,29 @@ type HyperblockQueryOptions struct {
 	NotarizedAtSource      bool
 	WithAlteredAccounts    bool
 	AlteredAccountsOptions GetAlteredAccountsForBlockOptions
+	Filters                HyperblockFilters
+	AllowPartial           bool
+}
+
+// HyperblockFilters holds the filters applied to the transactions and to the altered accounts of a hyperblock. A
+// transaction has to match all the provided filters, and any of the values of a filter
+type HyperblockFilters struct {
+	Addresses []string
+	Tokens    []string
+	Functions []string
+	Status    string
+}
+
+// IsEmpty returns true if no filter is set
+func (filters HyperblockFilters) IsEmpty() bool {
+	return len(filters.Addresses) == 0 && len(filters.Tokens) == 0 && len(filters.Functions) == 0 && len(filters.Status) == 0
+}
+
+// MiniBlockEpochHints holds the optional hints used for finding the epoch of a miniblock: a transaction of the
+// miniblock and the block holding it
+type MiniBlockEpochHints struct {
+	TxHash    string
+	BlockHash string
 }
 
 // TransactionQueryOptions holds options for transaction queries
@@ -133,6 +208,61 @@ func (a AccountQueryOptions) AreHistoricalCoordinatesSet() bool {
 		len(a.BlockRootHash) > 0
 }
 
+// EventsSearchOptions holds the criteria of an events search over a hyperblocks range. Empty criteria match any event,
+// while the topics are matched by position. At most Limit events are returned, and the first FromEventIndex matching
+// events of the FromNonce hyperblock are skipped, so that a search can be resumed
+type EventsSearchOptions struct {
+	FromNonce      uint64
+	ToNonce        uint64
+	Identifier     string
+	Address        string
+	Topics         []string
+	Limit          uint64
+	FromEventIndex uint64
+}
+
+// BalanceHistoryOptions holds options for balance history queries. The points are sampled either over a block nonce
+// range or over an epoch range (at the start of each epoch), every Step nonces or epochs
+type BalanceHistoryOptions struct {
+	FromNonce core.OptionalUint64
+	ToNonce   core.OptionalUint64
+	FromEpoch core.OptionalUint32
+	ToEpoch   core.OptionalUint32
+	Step      uint64
+	Tokens    []string
+}
+
+// IsEpochRange returns true if the points are sampled over an epoch range
+func (b BalanceHistoryOptions) IsEpochRange() bool {
+	return b.FromEpoch.HasValue || b.ToEpoch.HasValue
+}
+
+// NumPoints returns the number of points described by the options, or 0 if the range is invalid
+func (b BalanceHistoryOptions) NumPoints() uint64 {
+	isNonceRange := b.FromNonce.HasValue || b.ToNonce.HasValue
+	if b.Step == 0 || isNonceRange == b.IsEpochRange() {
+		return 0
+	}
+
+	from, to := b.FromNonce, b.ToNonce
+	if b.IsEpochRange() {
+		from = core.OptionalUint64{Value: uint64(b.FromEpoch.Value), HasValue: b.FromEpoch.HasValue}
+		to = core.OptionalUint64{Value: uint64(b.ToEpoch.Value), HasValue: b.ToEpoch.HasValue}
+	}
+	if !from.HasValue || !to.HasValue || from.Value > to.Value {
+		return 0
+	}
+
+	return (to.Value-from.Value)/b.Step + 1
+}
+
+// AccountDiffOptions holds options for account state diff queries
+type AccountDiffOptions struct {
+	FromBlockNonce uint64
+	ToBlockNonce   uint64
+	WithKeys       bool
+}
+
 // BuildUrlWithAccountQueryOptions builds an URL with block query parameters
 func BuildUrlWithAccountQueryOptions(path string, options AccountQueryOptions) string {
 	u := url.URL{Path: path}
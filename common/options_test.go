@@ -114,3 +114,34 @@ func TestAccountQueryOptions_AreHistoricalCoordinatesSet(t *testing.T) {
 	}
 	require.True(t, queryWithHintEpoch.AreHistoricalCoordinatesSet())
 }
+
+func TestBalanceHistoryOptions_NumPoints(t *testing.T) {
+	t.Parallel()
+
+	nonce := func(value uint64) core.OptionalUint64 {
+		return core.OptionalUint64{Value: value, HasValue: true}
+	}
+	epoch := func(value uint32) core.OptionalUint32 {
+		return core.OptionalUint32{Value: value, HasValue: true}
+	}
+
+	require.Equal(t, uint64(0), BalanceHistoryOptions{}.NumPoints())
+	require.Equal(t, uint64(0), BalanceHistoryOptions{FromNonce: nonce(10), ToNonce: nonce(20)}.NumPoints())
+	require.Equal(t, uint64(0), BalanceHistoryOptions{FromNonce: nonce(10), Step: 1}.NumPoints())
+	require.Equal(t, uint64(0), BalanceHistoryOptions{FromNonce: nonce(20), ToNonce: nonce(10), Step: 1}.NumPoints())
+	require.Equal(t, uint64(0), BalanceHistoryOptions{FromNonce: nonce(10), ToEpoch: epoch(20), Step: 1}.NumPoints())
+	require.Equal(t, uint64(0), BalanceHistoryOptions{
+		FromNonce: nonce(10),
+		ToNonce:   nonce(20),
+		FromEpoch: epoch(1),
+		ToEpoch:   epoch(2),
+		Step:      1,
+	}.NumPoints())
+
+	require.Equal(t, uint64(1), BalanceHistoryOptions{FromNonce: nonce(10), ToNonce: nonce(10), Step: 1}.NumPoints())
+	require.Equal(t, uint64(11), BalanceHistoryOptions{FromNonce: nonce(10), ToNonce: nonce(20), Step: 1}.NumPoints())
+	require.Equal(t, uint64(4), BalanceHistoryOptions{FromNonce: nonce(10), ToNonce: nonce(20), Step: 3}.NumPoints())
+	require.Equal(t, uint64(3), BalanceHistoryOptions{FromEpoch: epoch(5), ToEpoch: epoch(7), Step: 1}.NumPoints())
+	require.True(t, BalanceHistoryOptions{FromEpoch: epoch(5)}.IsEpochRange())
+	require.False(t, BalanceHistoryOptions{FromNonce: nonce(5)}.IsEpochRange())
+}
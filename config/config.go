@@ -11,6 +11,7 @@ type GeneralSettingsConfig struct {
 	HeartbeatCacheValidityDurationSec        int
 	ValStatsCacheValidityDurationSec         int
 	EconomicsMetricsCacheValidityDurationSec int
+	UsernamesCacheValidityDurationSec        int
 	FaucetValue                              string
 	RateLimitWindowDurationSeconds           int
 	BalancedObservers                        bool
@@ -18,6 +19,8 @@ type GeneralSettingsConfig struct {
 	AllowEntireTxPoolFetch                   bool
 	NumShardsTimeoutInSec                    int
 	TimeBetweenNodesRequestsInSec            int
+	HyperblocksStreamPollingIntervalMs       int
+	VerifyRawInternalBlocks                  bool
 }
 
 // Config will hold the whole config file's data
@@ -27,6 +30,8 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	BlocksCache            BlocksCacheConfig
+	Webhooks               WebhooksConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -49,6 +54,27 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// BlocksCacheConfig holds the configuration of the cache of final blocks and hyperblocks
+type BlocksCacheConfig struct {
+	Capacity       int
+	SpillToDisk    bool
+	SpillCapacity  int
+	SpillDirectory string
+}
+
+// WebhooksConfig holds the configuration of the webhook notifications
+type WebhooksConfig struct {
+	Enabled                  bool
+	StorePath                string
+	MaxSubscriptions         int
+	MaxRetries               uint32
+	InitialBackoffMs         int
+	MaxBackoffMs             int
+	RequestTimeoutSec        int
+	DeliveryLogSize          int
+	AllowPrivateDestinations bool
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
 	Credentials []data.Credential
@@ -50,6 +50,17 @@ type AccountApiResponse struct {
 	Code  string       `json:"code"`
 }
 
+// AccountsESDTBalancesRequest defines the request for the ESDT balances of multiple accounts
+type AccountsESDTBalancesRequest struct {
+	Addresses []string `json:"addresses"`
+	Tokens    []string `json:"tokens"`
+}
+
+// AccountsESDTBalances defines the ESDT balances of multiple accounts, indexed by address and then by token identifier
+type AccountsESDTBalances struct {
+	Balances map[string]map[string]string `json:"balances"`
+}
+
 // AccountsApiResponse defines the response that will be returned by the node when requesting multiple accounts
 type AccountsApiResponse struct {
 	Data  AccountsModel `json:"data"`
@@ -75,3 +86,206 @@ type IterateKeysRequest struct {
 	NumKeys       uint     `json:"numKeys"`
 	IteratorState [][]byte `json:"iteratorState"`
 }
+
+// AccountESDTToken holds the data of an ESDT token (fungible or not) owned by an account, as returned by the nodes
+type AccountESDTToken struct {
+	TokenIdentifier   string         `json:"tokenIdentifier"`
+	Balance           string         `json:"balance"`
+	Properties        string         `json:"properties,omitempty"`
+	Name              string         `json:"name,omitempty"`
+	Nonce             uint64         `json:"nonce,omitempty"`
+	Creator           string         `json:"creator,omitempty"`
+	Royalties         string         `json:"royalties,omitempty"`
+	Hash              []byte         `json:"hash,omitempty"`
+	URIs              [][]byte       `json:"uris,omitempty"`
+	Attributes        []byte         `json:"attributes,omitempty"`
+	DecodedAttributes *NFTAttributes `json:"decodedAttributes,omitempty"`
+}
+
+// NFTAttributes holds the human-readable form of the attributes of a non-fungible token. Fields is populated when
+// the attributes follow the "key1:value1;key2:value2" convention
+type NFTAttributes struct {
+	Text   string            `json:"text"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Guardian holds the data of an account's guardian
+type Guardian struct {
+	Address         string `json:"address"`
+	ActivationEpoch uint32 `json:"activationEpoch"`
+	ServiceUID      string `json:"serviceUID"`
+}
+
+// GuardianData holds the guardians of an account
+type GuardianData struct {
+	ActiveGuardian  *Guardian `json:"activeGuardian,omitempty"`
+	PendingGuardian *Guardian `json:"pendingGuardian,omitempty"`
+	Guarded         bool      `json:"guarded"`
+}
+
+// AccountESDTTokensApiResponse defines the response of a node when requesting all the ESDT tokens of an account
+type AccountESDTTokensApiResponse struct {
+	Data struct {
+		ESDTs     map[string]*AccountESDTToken `json:"esdts"`
+		BlockInfo BlockInfo                    `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountGuardianDataApiResponse defines the response of a node when requesting the guardian data of an account
+type AccountGuardianDataApiResponse struct {
+	Data struct {
+		GuardianData GuardianData `json:"guardianData"`
+		BlockInfo    BlockInfo    `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountCodeHashApiResponse defines the response of a node when requesting the code hash of an account
+type AccountCodeHashApiResponse struct {
+	Data struct {
+		CodeHash  []byte    `json:"codeHash"`
+		BlockInfo BlockInfo `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountESDTRolesApiResponse defines the response of a node when requesting the ESDT roles of an account
+type AccountESDTRolesApiResponse struct {
+	Data struct {
+		Roles     map[string][]string `json:"roles"`
+		BlockInfo BlockInfo           `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountPortfolio aggregates all the data of an account. Everything, except the ESDT roles that are held by the
+// metachain, is fetched from the same observer, at the block described by BlockInfo
+type AccountPortfolio struct {
+	Account           Account             `json:"account"`
+	Username          string              `json:"username"`
+	CodeHash          []byte              `json:"codeHash"`
+	FungibleTokens    []*AccountESDTToken `json:"fungibleTokens"`
+	NonFungibleTokens []*AccountESDTToken `json:"nonFungibleTokens"`
+	Roles             map[string][]string `json:"roles"`
+	GuardianData      GuardianData        `json:"guardianData"`
+	BlockInfo         BlockInfo           `json:"blockInfo"`
+}
+
+// AccountESDTTokenDataApiResponse defines the response of a node when requesting one ESDT token of an account
+type AccountESDTTokenDataApiResponse struct {
+	Data struct {
+		TokenData AccountESDTToken `json:"tokenData"`
+		BlockInfo BlockInfo        `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// BalanceHistoryPoint holds the balance, nonce and ESDT balances of an account at a given block or at the start of
+// a given epoch
+type BalanceHistoryPoint struct {
+	BlockNonce   uint64            `json:"blockNonce"`
+	Epoch        *uint32           `json:"epoch,omitempty"`
+	Balance      string            `json:"balance"`
+	Nonce        uint64            `json:"nonce"`
+	ESDTBalances map[string]string `json:"esdtBalances,omitempty"`
+	BlockInfo    BlockInfo         `json:"blockInfo"`
+}
+
+// AccountBalanceHistory defines the time series of the balances of an account, ordered by block nonce or epoch
+type AccountBalanceHistory struct {
+	Address string                 `json:"address"`
+	Points  []*BalanceHistoryPoint `json:"points"`
+}
+
+// AccountKeyValuePairsApiResponse defines the response of a node when requesting all the key-value pairs of an account
+type AccountKeyValuePairsApiResponse struct {
+	Data struct {
+		Pairs     map[string]string `json:"pairs"`
+		BlockInfo BlockInfo         `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ESDTTokenDiff defines how the balance of an ESDT token changed between two blocks
+type ESDTTokenDiff struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	BalanceBefore   string `json:"balanceBefore"`
+	BalanceAfter    string `json:"balanceAfter"`
+	BalanceDelta    string `json:"balanceDelta"`
+}
+
+// StorageValueDiff defines how the value of a storage key changed between two blocks
+type StorageValueDiff struct {
+	ValueBefore string `json:"valueBefore"`
+	ValueAfter  string `json:"valueAfter"`
+}
+
+// AccountStorageDiff defines the storage keys of an account that were added, removed or changed between two blocks
+type AccountStorageDiff struct {
+	Added   map[string]string            `json:"added"`
+	Removed map[string]string            `json:"removed"`
+	Changed map[string]*StorageValueDiff `json:"changed"`
+}
+
+// AccountDiff defines what changed in the state of an account between two blocks
+type AccountDiff struct {
+	Address         string              `json:"address"`
+	FromBlockInfo   BlockInfo           `json:"fromBlockInfo"`
+	ToBlockInfo     BlockInfo           `json:"toBlockInfo"`
+	BalanceBefore   string              `json:"balanceBefore"`
+	BalanceAfter    string              `json:"balanceAfter"`
+	BalanceDelta    string              `json:"balanceDelta"`
+	NonceBefore     uint64              `json:"nonceBefore"`
+	NonceAfter      uint64              `json:"nonceAfter"`
+	NonceDelta      int64               `json:"nonceDelta"`
+	CodeHashChanged bool                `json:"codeHashChanged"`
+	TokensAdded     []*ESDTTokenDiff    `json:"tokensAdded"`
+	TokensRemoved   []*ESDTTokenDiff    `json:"tokensRemoved"`
+	TokensChanged   []*ESDTTokenDiff    `json:"tokensChanged"`
+	Storage         *AccountStorageDiff `json:"storage,omitempty"`
+}
+
+const (
+	// StorageEntryTypeESDT defines the storage entry holding the balance of a fungible ESDT token
+	StorageEntryTypeESDT = "esdt"
+	// StorageEntryTypeNFT defines the storage entry holding an NFT, SFT or meta ESDT token
+	StorageEntryTypeNFT = "esdt-nft"
+	// StorageEntryTypeESDTMetaData defines the storage entry of the system account holding the metadata of an NFT, SFT or meta ESDT token
+	StorageEntryTypeESDTMetaData = "esdt-metadata"
+	// StorageEntryTypeESDTRoles defines the storage entry holding the roles of an account for an ESDT token
+	StorageEntryTypeESDTRoles = "esdt-roles"
+	// StorageEntryTypeNFTLastNonce defines the storage entry holding the last nonce created for an NFT collection
+	StorageEntryTypeNFTLastNonce = "esdt-nft-last-nonce"
+	// StorageEntryTypeGuardians defines the storage entry holding the guardians of an account
+	StorageEntryTypeGuardians = "guardians"
+	// StorageEntryTypeUnknown defines a storage entry that is not reserved by the protocol
+	StorageEntryTypeUnknown = "unknown"
+)
+
+// DecodedStorageEntry holds a storage key-value pair of an account, along with its decoded form when the key is
+// reserved by the protocol. Unknown keys and values get an UTF-8 rendering when printable
+type DecodedStorageEntry struct {
+	Key             string            `json:"key"`
+	Value           string            `json:"value"`
+	Type            string            `json:"type"`
+	TokenIdentifier string            `json:"tokenIdentifier,omitempty"`
+	Nonce           uint64            `json:"nonce,omitempty"`
+	Token           *AccountESDTToken `json:"token,omitempty"`
+	Roles           []string          `json:"roles,omitempty"`
+	Guardians       []*Guardian       `json:"guardians,omitempty"`
+	KeyText         string            `json:"keyText,omitempty"`
+	ValueText       string            `json:"valueText,omitempty"`
+}
+
+// DecodedKeyValuePairs holds the decoded storage entries of an account, indexed by their hex encoded key
+type DecodedKeyValuePairs struct {
+	Pairs     map[string]*DecodedStorageEntry `json:"pairs"`
+	BlockInfo BlockInfo                       `json:"blockInfo"`
+}
@@ -16,13 +16,26 @@ type GenericAPIResponse struct {
 // NetworkConfig is a dto that will keep information about the network config
 type NetworkConfig struct {
 	Config struct {
-		ChainID               string `json:"erd_chain_id"`
-		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
-		MinGasPrice           uint64 `json:"erd_min_gas_price"`
-		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+		ChainID                string  `json:"erd_chain_id"`
+		MinGasLimit            uint64  `json:"erd_min_gas_limit"`
+		MinGasPrice            uint64  `json:"erd_min_gas_price"`
+		MinTransactionVersion  uint32  `json:"erd_min_transaction_version"`
+		GasPerDataByte         uint64  `json:"erd_gas_per_data_byte"`
+		GasPriceModifier       float64 `json:"erd_gas_price_modifier,string"`
+		ExtraGasLimitGuardedTx uint64  `json:"erd_extra_gas_limit_guarded_tx"`
+		Denomination           int     `json:"erd_denomination"`
+		RoundDuration          int64   `json:"erd_round_duration"`
+		StartTime              int64   `json:"erd_start_time"`
 	} `json:"config"`
 }
 
+// NetworkConfigApiResponse represents the network config response from an observer
+type NetworkConfigApiResponse struct {
+	Data  NetworkConfig `json:"data"`
+	Error string        `json:"error"`
+	Code  ReturnCode    `json:"code"`
+}
+
 // ReturnCode defines the type defines to identify return codes
 type ReturnCode string
 
@@ -35,6 +48,10 @@ const (
 
 	// ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 	ReturnCodeRequestError ReturnCode = "bad_request"
+
+	// ReturnCodeIncompleteHyperblock defines a request for a hyperblock which hasn't been executed successfully because
+	// some of its notarized shard blocks could not be fetched
+	ReturnCodeIncompleteHyperblock ReturnCode = "incomplete_hyperblock"
 )
 
 // VersionData holds the components specific for each version
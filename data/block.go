@@ -29,14 +29,27 @@ func NewHyperblockApiResponse(hyperblock api.Hyperblock) *HyperblockApiResponse
 	return &HyperblockApiResponse{
 		Data: HyperblockApiResponsePayload{
 			Hyperblock: hyperblock,
+			Complete:   true,
 		},
 		Code: ReturnCodeSuccess,
 	}
 }
 
-// HyperblockApiResponsePayload wraps a hyperblock
+// HyperblockApiResponsePayload wraps a hyperblock, along with the notarized shard blocks which could not be fetched. A
+// hyperblock is complete only if all its notarized shard blocks have been fetched
 type HyperblockApiResponsePayload struct {
-	Hyperblock api.Hyperblock `json:"hyperblock"`
+	Hyperblock         api.Hyperblock       `json:"hyperblock"`
+	Complete           bool                 `json:"complete"`
+	MissingShardBlocks []*MissingShardBlock `json:"missingShardBlocks,omitempty"`
+}
+
+// MissingShardBlock holds a shard block notarized in a metablock which could not be fetched, or whose fetched hash does
+// not match the notarized one
+type MissingShardBlock struct {
+	Shard  uint32 `json:"shard"`
+	Nonce  uint64 `json:"nonce"`
+	Hash   string `json:"hash"`
+	Reason string `json:"reason"`
 }
 
 // InternalBlockApiResponse is a response holding an internal block
@@ -13,3 +13,9 @@ type BlocksApiResponse struct {
 type BlocksApiResponsePayload struct {
 	Blocks []*api.Block `json:"blocks"`
 }
+
+// RoundBlocks holds the blocks, from all shards, of a round
+type RoundBlocks struct {
+	Round  uint64       `json:"round"`
+	Blocks []*api.Block `json:"blocks"`
+}
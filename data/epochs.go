@@ -0,0 +1,68 @@
+package data
+
+// EpochStartData holds the details of the first block of an epoch, as exposed by the observers
+type EpochStartData struct {
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Timestamp int64  `json:"timestamp"`
+	Epoch     uint32 `json:"epoch"`
+	Shard     uint32 `json:"shard"`
+}
+
+// EpochStartDataApiResponse is a response holding the epoch-start data of a shard
+type EpochStartDataApiResponse struct {
+	Data  EpochStartDataApiResponsePayload `json:"data"`
+	Error string                           `json:"error"`
+	Code  ReturnCode                       `json:"code"`
+}
+
+// EpochStartDataApiResponsePayload wraps the epoch-start data
+type EpochStartDataApiResponsePayload struct {
+	EpochStart EpochStartData `json:"epochStart"`
+}
+
+// EpochBlockBoundary holds the nonce, round and timestamp of a block delimiting an epoch
+type EpochBlockBoundary struct {
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// ShardEpochBoundaries holds the first and the last block of a shard in an epoch. The last block is missing while the
+// shard is still in the epoch, while the first one is missing if the shard did not reach the epoch yet
+type ShardEpochBoundaries struct {
+	ShardID uint32              `json:"shardID"`
+	First   *EpochBlockBoundary `json:"first,omitempty"`
+	Last    *EpochBlockBoundary `json:"last,omitempty"`
+}
+
+// EpochBoundaries holds the boundaries of an epoch, for each shard
+type EpochBoundaries struct {
+	Epoch      uint32                  `json:"epoch"`
+	IsComplete bool                    `json:"isComplete"`
+	Shards     []*ShardEpochBoundaries `json:"shards"`
+}
+
+// EpochBoundariesApiResponse is a response holding the boundaries of an epoch
+type EpochBoundariesApiResponse struct {
+	Data  EpochBoundariesApiResponsePayload `json:"data"`
+	Error string                            `json:"error"`
+	Code  ReturnCode                        `json:"code"`
+}
+
+// EpochBoundariesApiResponsePayload wraps the boundaries of an epoch
+type EpochBoundariesApiResponsePayload struct {
+	Epoch *EpochBoundaries `json:"epoch"`
+}
+
+// EpochsBoundariesApiResponse is a response holding the boundaries of multiple epochs
+type EpochsBoundariesApiResponse struct {
+	Data  EpochsBoundariesApiResponsePayload `json:"data"`
+	Error string                             `json:"error"`
+	Code  ReturnCode                         `json:"code"`
+}
+
+// EpochsBoundariesApiResponsePayload wraps the boundaries of multiple epochs
+type EpochsBoundariesApiResponsePayload struct {
+	Epochs []*EpochBoundaries `json:"epochs"`
+}
@@ -7,3 +7,6 @@ var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrNilPubKeyConverter signals that a nil pub key converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrIncompleteHyperblock signals that a notarized shard block of a hyperblock could not be fetched
+var ErrIncompleteHyperblock = errors.New("incomplete hyperblock")
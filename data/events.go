@@ -0,0 +1,132 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// ErrInvalidEventsSearchResumeToken signals that the provided events search resume token is invalid
+var ErrInvalidEventsSearchResumeToken = errors.New("invalid events search resume token")
+
+// DecodedTopic holds the representations of an event topic. The string and the bech32 forms are only provided when
+// the topic is printable text, respectively an address
+type DecodedTopic struct {
+	Hex    string `json:"hex"`
+	String string `json:"string,omitempty"`
+	Bech32 string `json:"bech32,omitempty"`
+}
+
+// FoundEvent holds an event matching a search, along with the transaction, the shard and the block it originates from
+type FoundEvent struct {
+	Event           *transaction.Events `json:"event"`
+	DecodedTopics   []*DecodedTopic     `json:"decodedTopics"`
+	TxHash          string              `json:"txHash"`
+	Shard           uint32              `json:"shard"`
+	BlockNonce      uint64              `json:"blockNonce"`
+	BlockHash       string              `json:"blockHash"`
+	HyperblockNonce uint64              `json:"hyperblockNonce"`
+	HyperblockHash  string              `json:"hyperblockHash"`
+}
+
+// EventsSearchApiResponse is a response holding the events matching a search
+type EventsSearchApiResponse struct {
+	Data  EventsSearchApiResponsePayload `json:"data"`
+	Error string                         `json:"error"`
+	Code  ReturnCode                     `json:"code"`
+}
+
+// EventsSearchApiResponsePayload wraps the events matching a search. ResumeToken is only set when the search stopped
+// at its limit, and resumes it right after the last returned event
+type EventsSearchApiResponsePayload struct {
+	Events      []*FoundEvent `json:"events"`
+	ResumeToken string        `json:"resumeToken,omitempty"`
+}
+
+// EventsSearchResumeState holds what is needed to resume an events search: the hyperblock nonce and the number of
+// matching events of that hyperblock already returned
+type EventsSearchResumeState struct {
+	Nonce      uint64 `json:"nonce"`
+	EventIndex uint64 `json:"eventIndex"`
+}
+
+// ToToken encodes the state as an opaque token that can be handed out to clients
+func (state *EventsSearchResumeState) ToToken() string {
+	buff, _ := json.Marshal(state)
+
+	return base64.RawURLEncoding.EncodeToString(buff)
+}
+
+// NewEventsSearchResumeStateFromToken decodes the state from a token created with ToToken
+func NewEventsSearchResumeStateFromToken(token string) (*EventsSearchResumeState, error) {
+	buff, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidEventsSearchResumeToken
+	}
+
+	state := &EventsSearchResumeState{}
+	err = json.Unmarshal(buff, state)
+	if err != nil {
+		return nil, ErrInvalidEventsSearchResumeToken
+	}
+
+	return state, nil
+}
+
+// DecodedEventData holds the typed fields extracted from the topics of a well-known protocol event. Only the fields
+// relevant for the event identifier are filled
+type DecodedEventData struct {
+	Token      string             `json:"token,omitempty"`
+	Nonce      uint64             `json:"nonce,omitempty"`
+	Amount     string             `json:"amount,omitempty"`
+	Sender     string             `json:"sender,omitempty"`
+	Receiver   string             `json:"receiver,omitempty"`
+	Transfers  []*DecodedTransfer `json:"transfers,omitempty"`
+	Message    string             `json:"message,omitempty"`
+	ReturnData []string           `json:"returnData,omitempty"`
+	TxHash     string             `json:"txHash,omitempty"`
+}
+
+// DecodedTransfer holds one of the tokens moved by a multi transfer event
+type DecodedTransfer struct {
+	Token  string `json:"token"`
+	Nonce  uint64 `json:"nonce,omitempty"`
+	Amount string `json:"amount"`
+}
+
+// EventWithDecodedData extends the structure transaction.Events with the decoded section
+type EventWithDecodedData struct {
+	*transaction.Events
+	Decoded *DecodedEventData `json:"decoded,omitempty"`
+}
+
+// LogsWithDecodedEvents mirrors the structure transaction.ApiLogs, holding events with the decoded section
+type LogsWithDecodedEvents struct {
+	Address string                  `json:"address"`
+	Events  []*EventWithDecodedData `json:"events"`
+}
+
+// SmartContractResultWithDecodedEvents extends the structure transaction.ApiSmartContractResult by replacing its logs
+// with logs holding decoded events
+type SmartContractResultWithDecodedEvents struct {
+	*transaction.ApiSmartContractResult
+	Logs *LogsWithDecodedEvents `json:"logs,omitempty"`
+}
+
+// TransactionWithDecodedEvents extends the structure transaction.ApiTransactionResult by replacing its logs and the
+// logs of its smart contract results with logs holding decoded events
+type TransactionWithDecodedEvents struct {
+	*transaction.ApiTransactionResult
+	SmartContractResults []*SmartContractResultWithDecodedEvents `json:"smartContractResults,omitempty"`
+	Logs                 *LogsWithDecodedEvents                  `json:"logs,omitempty"`
+}
+
+// HyperblockWithDecodedEvents extends the structure api.Hyperblock by replacing its transactions with transactions
+// holding decoded events
+type HyperblockWithDecodedEvents struct {
+	*api.Hyperblock
+	Transactions []*TransactionWithDecodedEvents `json:"transactions"`
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidKeysStreamResumeToken signals that the provided keys stream resume token is invalid
+var ErrInvalidKeysStreamResumeToken = errors.New("invalid keys stream resume token")
+
+// IterateKeysApiResponse defines the response of a node when iterating the keys of an account
+type IterateKeysApiResponse struct {
+	Data struct {
+		Pairs            map[string]string `json:"pairs"`
+		NewIteratorState [][]byte          `json:"newIteratorState"`
+		BlockInfo        BlockInfo         `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// KeysStreamResumeState holds what is needed to resume a keys stream: the state root hash the stream was pinned to
+// and the iterator state reached
+type KeysStreamResumeState struct {
+	RootHash      string   `json:"rootHash"`
+	IteratorState [][]byte `json:"iteratorState"`
+}
+
+// ToToken encodes the state as an opaque token that can be handed out to clients
+func (state *KeysStreamResumeState) ToToken() string {
+	buff, _ := json.Marshal(state)
+
+	return base64.RawURLEncoding.EncodeToString(buff)
+}
+
+// NewKeysStreamResumeStateFromToken decodes the state from a token created with ToToken
+func NewKeysStreamResumeStateFromToken(token string) (*KeysStreamResumeState, error) {
+	buff, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidKeysStreamResumeToken
+	}
+
+	state := &KeysStreamResumeState{}
+	err = json.Unmarshal(buff, state)
+	if err != nil || len(state.RootHash) == 0 || len(state.IteratorState) == 0 {
+		return nil, ErrInvalidKeysStreamResumeToken
+	}
+
+	return state, nil
+}
+
+// AccountKeysPage defines one page of key-value pairs of a keys stream. ResumeToken can be used to resume the stream
+// right after this page and is empty for the last page
+type AccountKeysPage struct {
+	Pairs       map[string]string
+	BlockInfo   BlockInfo
+	ResumeToken string
+}
+
+// AccountKeyValuePair defines a key-value pair line of a keys stream
+type AccountKeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// AccountKeysStreamCheckpoint defines the line written after each page of a keys stream
+type AccountKeysStreamCheckpoint struct {
+	ResumeToken string    `json:"resumeToken"`
+	NumKeys     uint64    `json:"numKeys"`
+	BlockInfo   BlockInfo `json:"blockInfo"`
+}
+
+// AccountKeysStreamEnd defines the last line of a complete keys stream
+type AccountKeysStreamEnd struct {
+	Done    bool   `json:"done"`
+	NumKeys uint64 `json:"numKeys"`
+}
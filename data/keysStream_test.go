@@ -0,0 +1,36 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeysStreamResumeState_TokenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	state := &KeysStreamResumeState{
+		RootHash:      "abcd",
+		IteratorState: [][]byte{[]byte("first"), []byte("second")},
+	}
+
+	decoded, err := NewKeysStreamResumeStateFromToken(state.ToToken())
+	require.Nil(t, err)
+	require.Equal(t, state, decoded)
+}
+
+func TestNewKeysStreamResumeStateFromToken_InvalidTokensShouldError(t *testing.T) {
+	t.Parallel()
+
+	invalidTokens := []string{
+		"not base64 !",
+		(&KeysStreamResumeState{IteratorState: [][]byte{[]byte("state")}}).ToToken(),
+		(&KeysStreamResumeState{RootHash: "abcd"}).ToToken(),
+		"bm90IGpzb24",
+	}
+	for _, token := range invalidTokens {
+		state, err := NewKeysStreamResumeStateFromToken(token)
+		require.Nil(t, state)
+		require.Equal(t, ErrInvalidKeysStreamResumeToken, err)
+	}
+}
@@ -0,0 +1,18 @@
+package data
+
+// StreamError defines the payload written when a NDJSON or server-sent events stream is interrupted by an error
+type StreamError struct {
+	Error string `json:"error"`
+}
+
+// HyperblocksStreamEnd defines the last line of a complete hyperblocks stream
+type HyperblocksStreamEnd struct {
+	Done           bool   `json:"done"`
+	NumHyperblocks uint64 `json:"numHyperblocks"`
+}
+
+// BlocksStreamEnd defines the last line of a complete blocks stream
+type BlocksStreamEnd struct {
+	Done      bool   `json:"done"`
+	NumBlocks uint64 `json:"numBlocks"`
+}
@@ -166,12 +166,44 @@ type ResponseMultipleTransactions struct {
 	Code  string                           `json:"code"`
 }
 
+// TransactionDryRunResult holds the outcome of a transaction that went through the sending pipeline without being broadcast
+type TransactionDryRunResult struct {
+	TxHash            string                                  `json:"txHash"`
+	SenderShardID     uint32                                  `json:"senderShard"`
+	ReceiverShardID   uint32                                  `json:"receiverShard"`
+	Observer          string                                  `json:"observer"`
+	SimulationResults map[string]TransactionSimulationResults `json:"simulationResults"`
+}
+
+// MultipleTransactionsDryRunResponseData holds the outcome of a bulk of transactions that went through the sending
+// pipeline without being broadcast
+type MultipleTransactionsDryRunResponseData struct {
+	NumOfTxs uint64                           `json:"numOfTxs"`
+	Results  map[int]*TransactionDryRunResult `json:"results"`
+	Errors   map[int]string                   `json:"errors"`
+}
+
 // TxCostResponseData follows the format of the data field of a transaction cost request
 type TxCostResponseData struct {
 	TxCost     uint64                                     `json:"txGasUnits"`
 	RetMessage string                                     `json:"returnMessage"`
 	ScResults  map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
 	Logs       *transaction.ApiLogs                       `json:"logs,omitempty"`
+	// FeeBreakdown is computed by the proxy and only filled when explicitly requested
+	FeeBreakdown *FeeBreakdown `json:"feeBreakdown,omitempty"`
+}
+
+// FeeBreakdown holds the split of a transaction fee between its move balance and processing components.
+// All the amounts are expressed in the smallest denomination, except FeeDenominated which is expressed in EGLD
+type FeeBreakdown struct {
+	GasUsed            uint64 `json:"gasUsed"`
+	MoveBalanceGasUsed uint64 `json:"moveBalanceGasUsed"`
+	ProcessingGasUsed  uint64 `json:"processingGasUsed"`
+	MoveBalanceFee     string `json:"moveBalanceFee"`
+	ProcessingFee      string `json:"processingFee"`
+	RefundedFee        string `json:"refundedFee"`
+	Fee                string `json:"fee"`
+	FeeDenominated     string `json:"feeDenominated"`
 }
 
 // ExtendedApiSmartContractResult extends the structure transaction.ApiSmartContractResult with an extra field
@@ -282,8 +314,51 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Code  string                                         `json:"code"`
 }
 
+// NonceReservation holds a range of consecutive nonces reserved by the proxy for a sender
+type NonceReservation struct {
+	Address       string `json:"address"`
+	FirstNonce    uint64 `json:"firstNonce"`
+	LastNonce     uint64 `json:"lastNonce"`
+	Count         uint64 `json:"count"`
+	AccountNonce  uint64 `json:"accountNonce"`
+	LastPoolNonce uint64 `json:"lastPoolNonce"`
+}
+
+// NonceReservationSync holds the state of the nonce reservations of a sender after being re-aligned with the chain
+type NonceReservationSync struct {
+	Address       string     `json:"address"`
+	NextNonce     uint64     `json:"nextNonce"`
+	AccountNonce  uint64     `json:"accountNonce"`
+	LastPoolNonce uint64     `json:"lastPoolNonce"`
+	NonceGaps     []NonceGap `json:"nonceGaps"`
+}
+
+// ProcessStatusFailureRule identifies the rule that marked a processed transaction as failed
+type ProcessStatusFailureRule string
+
+const (
+	// FailureRuleInvalidTransaction signals that the transaction was marked as invalid by the protocol
+	FailureRuleInvalidTransaction ProcessStatusFailureRule = "invalidTransaction"
+	// FailureRuleInternalVMErrors signals that an internalVMErrors event was found in the logs
+	FailureRuleInternalVMErrors ProcessStatusFailureRule = "internalVMErrors"
+	// FailureRuleSignalError signals that a signalError event was found in the logs
+	FailureRuleSignalError ProcessStatusFailureRule = "signalError"
+	// FailureRuleReturnMessage signals that a smart contract result carried a failed return message
+	FailureRuleReturnMessage ProcessStatusFailureRule = "returnMessage"
+	// FailureRuleRelayedTxFailure signals that the inner transaction of a relayed transaction failed
+	FailureRuleRelayedTxFailure ProcessStatusFailureRule = "relayedTxFailure"
+)
+
+// ProcessStatusFailureDetails holds the details about why a processed transaction was marked as failed
+type ProcessStatusFailureDetails struct {
+	Rule       ProcessStatusFailureRule `json:"rule"`
+	SourceHash string                   `json:"sourceHash"`
+	Message    string                   `json:"message"`
+}
+
 // ProcessStatusResponse represents a structure that holds the process status of a transaction
 type ProcessStatusResponse struct {
-	Status string `json:"status"`
-	Reason string `json:"reason"`
+	Status  string                       `json:"status"`
+	Reason  string                       `json:"reason"`
+	Failure *ProcessStatusFailureDetails `json:"failure,omitempty"`
 }
@@ -0,0 +1,15 @@
+package data
+
+// UsernameResolution defines the address a username resolves to, along with the DNS contract that resolved it
+type UsernameResolution struct {
+	Username   string `json:"username"`
+	Address    string `json:"address"`
+	DNSAddress string `json:"dnsAddress"`
+}
+
+// AddressUsername defines the username of an address. The username is verified if it resolves back to the same address
+type AddressUsername struct {
+	Address    string `json:"address"`
+	Username   string `json:"username"`
+	IsVerified bool   `json:"isVerified"`
+}
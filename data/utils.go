@@ -0,0 +1,34 @@
+package data
+
+// AddressesRequest defines the request body of the endpoints receiving a bulk of addresses
+type AddressesRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+// AddressConversion defines the result of converting an address from one format to the other
+type AddressConversion struct {
+	Input  string `json:"input"`
+	Output string `json:"output,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// AddressShardID defines the shard ID computed for an address
+type AddressShardID struct {
+	Address string  `json:"address"`
+	ShardID *uint32 `json:"shardID,omitempty"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// AddressValidation defines the result of validating an address
+type AddressValidation struct {
+	Address string `json:"address"`
+	IsValid bool   `json:"isValid"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ContractAddress defines the address of a smart contract deployed by a sender at a given nonce
+type ContractAddress struct {
+	Address string `json:"address"`
+	Hex     string `json:"hex"`
+	ShardID uint32 `json:"shardID"`
+}
@@ -0,0 +1,58 @@
+package data
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// WebhookFilter holds the criteria a transaction has to match in order to be notified. A transaction has to match all
+// the provided criteria, and any of the values of a criterion
+type WebhookFilter struct {
+	Addresses   []string `json:"addresses,omitempty"`
+	Tokens      []string `json:"tokens,omitempty"`
+	Identifiers []string `json:"identifiers,omitempty"`
+	Functions   []string `json:"functions,omitempty"`
+}
+
+// IsEmpty returns true if no criterion has been provided
+func (filter WebhookFilter) IsEmpty() bool {
+	return len(filter.Addresses) == 0 && len(filter.Tokens) == 0 && len(filter.Identifiers) == 0 && len(filter.Functions) == 0
+}
+
+// WebhookSubscriptionRequest represents the request of registering a webhook. If the secret is missing, one is
+// generated and returned along with the created subscription
+type WebhookSubscriptionRequest struct {
+	URL    string        `json:"url"`
+	Secret string        `json:"secret"`
+	Filter WebhookFilter `json:"filter"`
+}
+
+// WebhookSubscription holds a registered webhook. The secret is only returned when the webhook is registered
+type WebhookSubscription struct {
+	ID        string        `json:"id"`
+	Owner     string        `json:"owner"`
+	URL       string        `json:"url"`
+	Secret    string        `json:"secret,omitempty"`
+	Filter    WebhookFilter `json:"filter"`
+	CreatedAt int64         `json:"createdAt"`
+}
+
+// WebhookNotification is the payload posted to a webhook, holding the transactions of a hyperblock matching its filter
+// and the events logged by them
+type WebhookNotification struct {
+	SubscriptionID  string                              `json:"subscriptionId"`
+	HyperblockNonce uint64                              `json:"hyperblockNonce"`
+	HyperblockHash  string                              `json:"hyperblockHash"`
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions"`
+	Events          []*FoundEvent                       `json:"events"`
+}
+
+// WebhookDelivery holds the outcome of posting a notification to a webhook
+type WebhookDelivery struct {
+	SubscriptionID  string `json:"subscriptionId"`
+	HyperblockNonce uint64 `json:"hyperblockNonce"`
+	HyperblockHash  string `json:"hyperblockHash"`
+	NumTxs          int    `json:"numTxs"`
+	Attempts        uint32 `json:"attempts"`
+	Delivered       bool   `json:"delivered"`
+	StatusCode      int    `json:"statusCode,omitempty"`
+	Error           string `json:"error,omitempty"`
+	Timestamp       int64  `json:"timestamp"`
+}
@@ -1,10 +1,12 @@
 package facade
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
@@ -24,6 +26,8 @@ var _ groups.TransactionFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.ValidatorFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.VmValuesFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.ProofFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.UtilsFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.UsernameFacadeHandler = (*ProxyFacade)(nil)
 
 // ProxyFacade implements the facade used in api calls
 type ProxyFacade struct {
@@ -41,8 +45,11 @@ type ProxyFacade struct {
 	esdtSuppliesProc ESDTSupplyProcessor
 	statusProc       StatusProcessor
 
-	pubKeyConverter core.PubkeyConverter
-	aboutInfoProc   AboutInfoProcessor
+	pubKeyConverter     core.PubkeyConverter
+	aboutInfoProc       AboutInfoProcessor
+	usernameProc        UsernameProcessor
+	hyperblocksFollower HyperblocksFollower
+	webhooksProc        WebhooksProcessor
 }
 
 // NewProxyFacade creates a new ProxyFacade instance
@@ -62,6 +69,9 @@ func NewProxyFacade(
 	esdtSuppliesProc ESDTSupplyProcessor,
 	statusProc StatusProcessor,
 	aboutInfoProc AboutInfoProcessor,
+	usernameProc UsernameProcessor,
+	hyperblocksFollower HyperblocksFollower,
+	webhooksProc WebhooksProcessor,
 ) (*ProxyFacade, error) {
 	if actionsProc == nil {
 		return nil, ErrNilActionsProcessor
@@ -105,23 +115,35 @@ func NewProxyFacade(
 	if aboutInfoProc == nil {
 		return nil, ErrNilAboutInfoProcessor
 	}
+	if usernameProc == nil {
+		return nil, ErrNilUsernameProcessor
+	}
+	if hyperblocksFollower == nil {
+		return nil, ErrNilHyperblocksFollower
+	}
+	if webhooksProc == nil {
+		return nil, ErrNilWebhooksProcessor
+	}
 
 	return &ProxyFacade{
-		actionsProc:      actionsProc,
-		accountProc:      accountProc,
-		txProc:           txProc,
-		scQueryService:   scQueryService,
-		nodeGroupProc:    nodeGroupProc,
-		valStatsProc:     valStatsProc,
-		faucetProc:       faucetProc,
-		nodeStatusProc:   nodeStatusProc,
-		blockProc:        blockProc,
-		blocksProc:       blocksProc,
-		proofProc:        proofProc,
-		pubKeyConverter:  pubKeyConverter,
-		esdtSuppliesProc: esdtSuppliesProc,
-		statusProc:       statusProc,
-		aboutInfoProc:    aboutInfoProc,
+		actionsProc:         actionsProc,
+		accountProc:         accountProc,
+		txProc:              txProc,
+		scQueryService:      scQueryService,
+		nodeGroupProc:       nodeGroupProc,
+		valStatsProc:        valStatsProc,
+		faucetProc:          faucetProc,
+		nodeStatusProc:      nodeStatusProc,
+		blockProc:           blockProc,
+		blocksProc:          blocksProc,
+		proofProc:           proofProc,
+		pubKeyConverter:     pubKeyConverter,
+		esdtSuppliesProc:    esdtSuppliesProc,
+		statusProc:          statusProc,
+		aboutInfoProc:       aboutInfoProc,
+		usernameProc:        usernameProc,
+		hyperblocksFollower: hyperblocksFollower,
+		webhooksProc:        webhooksProc,
 	}, nil
 }
 
@@ -205,6 +227,16 @@ func (pf *ProxyFacade) SimulateTransaction(tx *data.Transaction, checkSignature
 	return pf.txProc.SimulateTransaction(tx, checkSignature)
 }
 
+// DryRunTransaction should validate, route and simulate the transaction without broadcasting it
+func (pf *ProxyFacade) DryRunTransaction(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+	return pf.txProc.DryRunTransaction(tx)
+}
+
+// DryRunMultipleTransactions should validate, route and simulate the transactions without broadcasting them
+func (pf *ProxyFacade) DryRunMultipleTransactions(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error) {
+	return pf.txProc.DryRunMultipleTransactions(txs)
+}
+
 // TransactionCostRequest should return how many gas units a transaction will cost
 func (pf *ProxyFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return pf.txProc.TransactionCostRequest(tx)
@@ -225,6 +257,31 @@ func (pf *ProxyFacade) GetTransaction(txHash string, withResults bool) (*transac
 	return pf.txProc.GetTransaction(txHash, withResults)
 }
 
+// GetTransactionFeeBreakdown returns the fee breakdown of an executed transaction
+func (pf *ProxyFacade) GetTransactionFeeBreakdown(tx *transaction.ApiTransactionResult) (*data.FeeBreakdown, error) {
+	networkCfg, err := pf.getNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.ComputeTransactionFeeBreakdown(tx, networkCfg)
+}
+
+// DecodeTransactionEvents returns the transaction along with the decoded section of its well-known events
+func (pf *ProxyFacade) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents {
+	return pf.txProc.DecodeTransactionEvents(tx)
+}
+
+// GetTransactionCostFeeBreakdown returns the fee breakdown of a transaction cost estimation
+func (pf *ProxyFacade) GetTransactionCostFeeBreakdown(tx *data.Transaction, cost *data.TxCostResponseData) (*data.FeeBreakdown, error) {
+	networkCfg, err := pf.getNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return pf.txProc.ComputeTransactionCostFeeBreakdown(tx, cost, networkCfg)
+}
+
 // ReloadObservers will try to reload the observers
 func (pf *ProxyFacade) ReloadObservers() data.NodesReloadResponse {
 	return pf.actionsProc.ReloadObservers()
@@ -365,6 +422,17 @@ func (pf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryO
 	return pf.blocksProc.GetBlocksByRound(round, options)
 }
 
+// StreamBlocksByRound hands the blocks, from all shards, of the rounds between the provided ones, in round order, to the
+// provided handler
+func (pf *ProxyFacade) StreamBlocksByRound(
+	fromRound uint64,
+	toRound uint64,
+	options common.BlockQueryOptions,
+	handler func(roundBlocks *data.RoundBlocks) error,
+) error {
+	return pf.blocksProc.StreamBlocksByRound(fromRound, toRound, options, handler)
+}
+
 // GetInternalBlockByHash retrieves the internal block by hash for a given shard
 func (pf *ProxyFacade) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return pf.blockProc.GetInternalBlockByHash(shardID, hash, format)
@@ -380,6 +448,11 @@ func (pf *ProxyFacade) GetInternalStartOfEpochMetaBlock(epoch uint32, format com
 	return pf.blockProc.GetInternalStartOfEpochMetaBlock(epoch, format)
 }
 
+// GetInternalMiniBlockByHashWithoutEpoch retrieves the internal miniblock by hash for a given shard, finding its epoch
+func (pf *ProxyFacade) GetInternalMiniBlockByHashWithoutEpoch(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	return pf.blockProc.GetInternalMiniBlockByHashWithoutEpoch(shardID, hash, hints, format)
+}
+
 // GetInternalMiniBlockByHash retrieves the internal miniblock by hash for a given shard
 func (pf *ProxyFacade) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return pf.blockProc.GetInternalMiniBlockByHash(shardID, hash, epoch, format)
@@ -395,6 +468,53 @@ func (pf *ProxyFacade) GetHyperBlockByNonce(nonce uint64, options common.Hyperbl
 	return pf.blockProc.GetHyperBlockByNonce(nonce, options)
 }
 
+// GetBlockByTimestamp retrieves the latest block of a given shard produced at or before the provided timestamp
+func (pf *ProxyFacade) GetBlockByTimestamp(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByTimestamp(shardID, timestamp, options)
+}
+
+// StreamBlocks hands the blocks of a shard between the provided nonces, in nonce order, to the provided handler
+func (pf *ProxyFacade) StreamBlocks(
+	shardID uint32,
+	fromNonce uint64,
+	toNonce uint64,
+	options common.BlockQueryOptions,
+	handler func(block *api.Block) error,
+) error {
+	return pf.blockProc.StreamBlocks(shardID, fromNonce, toNonce, options, handler)
+}
+
+// GetHyperBlockByTimestamp retrieves the latest hyperblock produced at or before the provided timestamp
+func (pf *ProxyFacade) GetHyperBlockByTimestamp(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return pf.blockProc.GetHyperBlockByTimestamp(timestamp, options)
+}
+
+// DecodeHyperblockEvents returns the hyperblock along with the decoded section of the well-known events of its transactions
+func (pf *ProxyFacade) DecodeHyperblockEvents(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents {
+	return pf.txProc.DecodeHyperblockEvents(hyperblock)
+}
+
+// StreamHyperBlocks hands the hyperblocks between the provided nonces, in nonce order, to the provided handler
+func (pf *ProxyFacade) StreamHyperBlocks(
+	fromNonce uint64,
+	toNonce uint64,
+	options common.HyperblockQueryOptions,
+	handler func(hyperblock *api.Hyperblock) error,
+) error {
+	return pf.blockProc.StreamHyperBlocks(fromNonce, toNonce, options, handler)
+}
+
+// FollowHyperBlocks hands the hyperblocks, in nonce order, to the provided handler as soon as they are fully
+// synchronized across shards, until the context is done
+func (pf *ProxyFacade) FollowHyperBlocks(
+	ctx context.Context,
+	fromNonce core.OptionalUint64,
+	options common.HyperblockQueryOptions,
+	handler func(hyperblock *api.Hyperblock) error,
+) error {
+	return pf.hyperblocksFollower.FollowHyperBlocks(ctx, fromNonce, options, handler)
+}
+
 // ValidatorStatistics will return the statistics from an observer
 func (pf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
 	valStats, err := pf.valStatsProc.GetValidatorStatistics()
@@ -460,6 +580,16 @@ func (pf *ProxyFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*da
 	return pf.txProc.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// ReserveNonces reserves a range of consecutive nonces for the provided address
+func (pf *ProxyFacade) ReserveNonces(address string, count uint64) (*data.NonceReservation, error) {
+	return pf.txProc.ReserveNonces(address, count)
+}
+
+// SyncNonceReservations re-aligns the nonce reservations of the provided address with the chain state
+func (pf *ProxyFacade) SyncNonceReservations(address string) (*data.NonceReservationSync, error) {
+	return pf.txProc.SyncNonceReservations(address)
+}
+
 // GetProof returns the Merkle proof for the given address
 func (pf *ProxyFacade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
 	return pf.proofProc.GetProof(rootHash, address)
@@ -525,6 +655,21 @@ func (pf *ProxyFacade) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsA
 	return pf.nodeStatusProc.GetTriesStatistics(shardID)
 }
 
+// GetEpochBoundaries retrieves the first and the last block of each shard in the provided epoch
+func (pf *ProxyFacade) GetEpochBoundaries(epoch uint32) (*data.EpochBoundariesApiResponse, error) {
+	return pf.blockProc.GetEpochBoundaries(epoch)
+}
+
+// GetEpochsBoundaries retrieves the first and the last block of each shard in the epochs between the provided ones
+func (pf *ProxyFacade) GetEpochsBoundaries(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error) {
+	return pf.blockProc.GetEpochsBoundaries(fromEpoch, toEpoch)
+}
+
+// SearchEvents retrieves the events logged in a hyperblocks range which match the provided criteria
+func (pf *ProxyFacade) SearchEvents(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+	return pf.blockProc.SearchEvents(options)
+}
+
 // GetEpochStartData retrieves epoch start data for the provides epoch and shard ID
 func (pf *ProxyFacade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	return pf.nodeStatusProc.GetEpochStartData(epoch, shardID)
@@ -549,3 +694,99 @@ func (pf *ProxyFacade) IsDataTrieMigrated(address string, options common.Account
 func (pf *ProxyFacade) IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return pf.accountProc.IterateKeys(address, numKeys, iteratorState, options)
 }
+
+// GetAccountPortfolio returns all the data of an account, fetched at the same block
+func (pf *ProxyFacade) GetAccountPortfolio(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error) {
+	return pf.accountProc.GetAccountPortfolio(address, options)
+}
+
+// GetESDTBalancesForAccounts returns the ESDT balances of the provided accounts
+func (pf *ProxyFacade) GetESDTBalancesForAccounts(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error) {
+	return pf.accountProc.GetESDTBalancesForAccounts(addresses, tokens, options)
+}
+
+// GetBalanceHistory returns the balances of an account sampled over a nonce or an epoch range
+func (pf *ProxyFacade) GetBalanceHistory(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error) {
+	return pf.accountProc.GetBalanceHistory(address, options)
+}
+
+// GetAccountDiff returns what changed in the state of an account between two blocks
+func (pf *ProxyFacade) GetAccountDiff(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	return pf.accountProc.GetAccountDiff(address, options)
+}
+
+// GetDecodedKeyValuePairs returns the key-value pairs of an account, decoding the ones reserved by the protocol
+func (pf *ProxyFacade) GetDecodedKeyValuePairs(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error) {
+	return pf.accountProc.GetDecodedKeyValuePairs(address, options)
+}
+
+// GetDecodedValueForKey returns the value for a key of an account, decoded if the key is reserved by the protocol
+func (pf *ProxyFacade) GetDecodedValueForKey(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error) {
+	return pf.accountProc.GetDecodedValueForKey(address, key, options)
+}
+
+// ConvertBech32AddressesToHex converts the provided bech32 addresses to hex
+func (pf *ProxyFacade) ConvertBech32AddressesToHex(addresses []string) []*data.AddressConversion {
+	return pf.accountProc.ConvertBech32AddressesToHex(addresses)
+}
+
+// ConvertHexAddressesToBech32 converts the provided hex addresses to bech32
+func (pf *ProxyFacade) ConvertHexAddressesToBech32(addresses []string) []*data.AddressConversion {
+	return pf.accountProc.ConvertHexAddressesToBech32(addresses)
+}
+
+// ComputeShardIDsForAddresses returns the computed shard IDs for the given addresses based on the current proxy's configuration
+func (pf *ProxyFacade) ComputeShardIDsForAddresses(addresses []string) []*data.AddressShardID {
+	return pf.accountProc.ComputeShardIDsForAddresses(addresses)
+}
+
+// ValidateAddresses checks the human readable part and the length of the given addresses
+func (pf *ProxyFacade) ValidateAddresses(addresses []string) []*data.AddressValidation {
+	return pf.accountProc.ValidateAddresses(addresses)
+}
+
+// ComputeContractAddress returns the address of the smart contract deployed by the given sender at the given nonce
+func (pf *ProxyFacade) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	return pf.accountProc.ComputeContractAddress(deployer, nonce)
+}
+
+// ResolveUsername returns the address the given username is registered for
+func (pf *ProxyFacade) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	return pf.usernameProc.ResolveUsername(username)
+}
+
+// GetUsernameForAddress returns the username of the given address, verified through the DNS contracts
+func (pf *ProxyFacade) GetUsernameForAddress(address string) (*data.AddressUsername, error) {
+	return pf.usernameProc.GetUsernameForAddress(address)
+}
+
+// StreamKeys hands all the key-value pairs of an account, page by page, to the provided handler
+func (pf *ProxyFacade) StreamKeys(
+	address string,
+	numKeysPerPage uint,
+	resumeState *data.KeysStreamResumeState,
+	options common.AccountQueryOptions,
+	handler func(page *data.AccountKeysPage) error,
+) error {
+	return pf.accountProc.StreamKeys(address, numKeysPerPage, resumeState, options, handler)
+}
+
+// RegisterWebhook registers a webhook notified with the transactions matching the provided filter
+func (pf *ProxyFacade) RegisterWebhook(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+	return pf.webhooksProc.RegisterWebhook(owner, request)
+}
+
+// GetWebhooks returns the webhooks registered by the provided owner
+func (pf *ProxyFacade) GetWebhooks(owner string) []*data.WebhookSubscription {
+	return pf.webhooksProc.GetWebhooks(owner)
+}
+
+// RemoveWebhook removes a webhook registered by the provided owner
+func (pf *ProxyFacade) RemoveWebhook(owner string, id string) error {
+	return pf.webhooksProc.RemoveWebhook(owner, id)
+}
+
+// GetWebhookDeliveries returns the latest deliveries of a webhook registered by the provided owner
+func (pf *ProxyFacade) GetWebhookDeliveries(owner string, id string) ([]*data.WebhookDelivery, error) {
+	return pf.webhooksProc.GetWebhookDeliveries(owner, id)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
@@ -45,6 +46,9 @@ func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -70,6 +74,9 @@ func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -95,6 +102,9 @@ func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -120,6 +130,9 @@ func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -145,6 +158,9 @@ func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -170,6 +186,9 @@ func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -195,6 +214,9 @@ func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -220,6 +242,9 @@ func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -245,6 +270,9 @@ func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -270,6 +298,9 @@ func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -295,6 +326,9 @@ func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -320,12 +354,99 @@ func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		nil,
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
 }
 
+func TestNewProxyFacade_NilUsernameProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		nil,
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilUsernameProcessor, err)
+}
+
+func TestNewProxyFacade_NilHyperblocksFollowerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		nil,
+		&mock.WebhooksProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilHyperblocksFollower, err)
+}
+
+func TestNewProxyFacade_NilWebhooksProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilWebhooksProcessor, err)
+}
+
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -345,6 +466,9 @@ func TestNewProxyFacade_ShouldWork(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -393,6 +517,9 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 	require.NoError(t, err)
 
@@ -430,6 +557,9 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
@@ -463,6 +593,9 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	_, _, _ = epf.SendTransaction(&data.Transaction{})
@@ -495,6 +628,9 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
@@ -502,6 +638,41 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestProxyFacade_DryRunTransaction(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			DryRunTransactionCalled: func(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+				wasCalled = true
+				return 0, nil, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
+	)
+
+	_, _, _ = epf.DryRunTransaction(&data.Transaction{})
+
+	assert.True(t, wasCalled)
+}
+
 func TestProxyFacade_SendUserFunds(t *testing.T) {
 	t.Parallel()
 
@@ -553,6 +724,9 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	_ = epf.SendUserFunds("", big.NewInt(0))
@@ -585,6 +759,9 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	_, _, _ = epf.ExecuteSCQuery(nil)
@@ -623,6 +800,9 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, _ := epf.GetHeartbeatData()
@@ -658,6 +838,9 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -693,6 +876,9 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -732,6 +918,9 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
@@ -772,6 +961,9 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
@@ -814,6 +1006,9 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -854,6 +1049,9 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
@@ -894,6 +1092,9 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -932,6 +1133,9 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetRatingsConfig()
@@ -997,6 +1201,9 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualTxPool, err := epf.GetTransactionsPool("")
@@ -1051,6 +1258,9 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, err := epf.GetGasConfigs()
@@ -1088,6 +1298,9 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
 	)
 
 	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey("key")
@@ -1095,6 +1308,57 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	assert.Equal(t, expectedResults, actualResult)
 }
 
+func TestProxyFacade_GetTransactionFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.ApiTransactionResult{Hash: "hash"}
+	expectedBreakdown := &data.FeeBreakdown{Fee: "50000000000000"}
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			ComputeTransactionFeeBreakdownCalled: func(tx *transaction.ApiTransactionResult, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error) {
+				assert.Equal(t, providedTx, tx)
+				assert.Equal(t, uint64(50000), networkConfig.Config.MinGasLimit)
+				assert.Equal(t, uint64(1500), networkConfig.Config.GasPerDataByte)
+				assert.Equal(t, 0.01, networkConfig.Config.GasPriceModifier)
+				return expectedBreakdown, nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{
+			GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{
+						"config": map[string]interface{}{
+							"erd_min_gas_limit":      50000,
+							"erd_gas_per_data_byte":  1500,
+							"erd_gas_price_modifier": "0.01",
+						},
+					},
+				}, nil
+			},
+		},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.UsernameProcessorStub{},
+		&mock.HyperblocksFollowerStub{},
+		&mock.WebhooksProcessorStub{},
+	)
+
+	actualResult, err := epf.GetTransactionFeeBreakdown(providedTx)
+	require.NoError(t, err)
+	assert.Equal(t, expectedBreakdown, actualResult)
+}
+
 func getPrivKey() crypto.PrivateKey {
 	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
 	sk, _ := keyGen.GeneratePair()
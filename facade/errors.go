@@ -43,3 +43,12 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilUsernameProcessor signals that a nil username processor has been provided
+var ErrNilUsernameProcessor = errors.New("nil username processor")
+
+// ErrNilHyperblocksFollower signals that a nil hyperblocks follower has been provided
+var ErrNilHyperblocksFollower = errors.New("nil hyperblocks follower")
+
+// ErrNilWebhooksProcessor signals that a nil webhooks processor has been provided
+var ErrNilWebhooksProcessor = errors.New("nil webhooks processor")
@@ -1,8 +1,11 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
@@ -33,6 +36,18 @@ type AccountProcessor interface {
 	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAccountPortfolio(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error)
+	GetESDTBalancesForAccounts(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error)
+	GetBalanceHistory(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error)
+	GetAccountDiff(address string, options common.AccountDiffOptions) (*data.AccountDiff, error)
+	GetDecodedKeyValuePairs(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error)
+	GetDecodedValueForKey(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error)
+	ConvertBech32AddressesToHex(addresses []string) []*data.AddressConversion
+	ConvertHexAddressesToBech32(addresses []string) []*data.AddressConversion
+	ComputeShardIDsForAddresses(addresses []string) []*data.AddressShardID
+	ValidateAddresses(addresses []string) []*data.AddressValidation
+	ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error)
+	StreamKeys(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error
 }
 
 // TransactionProcessor defines what a transaction request processor should do
@@ -40,6 +55,8 @@ type TransactionProcessor interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	DryRunTransaction(tx *data.Transaction) (int, *data.TransactionDryRunResult, error)
+	DryRunMultipleTransactions(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error)
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
@@ -51,6 +68,12 @@ type TransactionProcessor interface {
 	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	ReserveNonces(sender string, count uint64) (*data.NonceReservation, error)
+	SyncNonceReservations(sender string) (*data.NonceReservationSync, error)
+	ComputeTransactionFeeBreakdown(tx *transaction.ApiTransactionResult, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error)
+	ComputeTransactionCostFeeBreakdown(tx *data.Transaction, cost *data.TxCostResponseData, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error)
+	DecodeTransactionEvents(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents
+	DecodeHyperblockEvents(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents
 }
 
 // ProofProcessor defines what a proof request processor should do
@@ -104,6 +127,7 @@ type NodeStatusProcessor interface {
 // BlocksProcessor defines what a blocks processor should do
 type BlocksProcessor interface {
 	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	StreamBlocksByRound(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error
 }
 
 // BlockProcessor defines what a block processor should do
@@ -112,15 +136,23 @@ type BlockProcessor interface {
 	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetBlockByTimestamp(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByTimestamp(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	StreamHyperBlocks(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	StreamBlocks(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error
 
 	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalMiniBlockByHashWithoutEpoch(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 
 	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetEpochBoundaries(epoch uint32) (*data.EpochBoundariesApiResponse, error)
+	GetEpochsBoundaries(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error)
+	SearchEvents(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error)
 }
 
 // FaucetProcessor defines what a component which will handle faucets should do
@@ -148,3 +180,22 @@ type AboutInfoProcessor interface {
 	GetAboutInfo() *data.GenericAPIResponse
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// UsernameProcessor defines what a username processor should do
+type UsernameProcessor interface {
+	ResolveUsername(username string) (*data.UsernameResolution, error)
+	GetUsernameForAddress(address string) (*data.AddressUsername, error)
+}
+
+// HyperblocksFollower defines what a component which follows the newly synchronized hyperblocks should do
+type HyperblocksFollower interface {
+	FollowHyperBlocks(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+}
+
+// WebhooksProcessor defines what a webhook subscriptions processor should do
+type WebhooksProcessor interface {
+	RegisterWebhook(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error)
+	GetWebhooks(owner string) []*data.WebhookSubscription
+	RemoveWebhook(owner string, id string) error
+	GetWebhookDeliveries(owner string, id string) ([]*data.WebhookDelivery, error)
+}
@@ -24,6 +24,18 @@ type AccountProcessorStub struct {
 	GetGuardianDataCalled                   func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigratedCalled                func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeysCalled                       func(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAccountPortfolioCalled               func(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error)
+	GetESDTBalancesForAccountsCalled        func(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error)
+	GetBalanceHistoryCalled                 func(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error)
+	GetAccountDiffCalled                    func(address string, options common.AccountDiffOptions) (*data.AccountDiff, error)
+	StreamKeysCalled                        func(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error
+	GetDecodedKeyValuePairsCalled           func(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error)
+	GetDecodedValueForKeyCalled             func(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error)
+	ConvertBech32AddressesToHexCalled       func(addresses []string) []*data.AddressConversion
+	ConvertHexAddressesToBech32Called       func(addresses []string) []*data.AddressConversion
+	ComputeShardIDsForAddressesCalled       func(addresses []string) []*data.AddressShardID
+	ValidateAddressesCalled                 func(addresses []string) []*data.AddressValidation
+	ComputeContractAddressCalled            func(deployer string, nonce uint64) (*data.ContractAddress, error)
 }
 
 // GetKeyValuePairs -
@@ -118,7 +130,115 @@ func (aps *AccountProcessorStub) IterateKeys(address string, numKeys uint, itera
 	return &data.GenericAPIResponse{}, nil
 }
 
+// GetAccountPortfolio -
+func (aps *AccountProcessorStub) GetAccountPortfolio(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error) {
+	if aps.GetAccountPortfolioCalled != nil {
+		return aps.GetAccountPortfolioCalled(address, options)
+	}
+
+	return &data.AccountPortfolio{}, nil
+}
+
+// GetESDTBalancesForAccounts -
+func (aps *AccountProcessorStub) GetESDTBalancesForAccounts(addresses []string, tokens []string, options common.AccountQueryOptions) (*data.AccountsESDTBalances, error) {
+	if aps.GetESDTBalancesForAccountsCalled != nil {
+		return aps.GetESDTBalancesForAccountsCalled(addresses, tokens, options)
+	}
+
+	return &data.AccountsESDTBalances{}, nil
+}
+
+// GetBalanceHistory -
+func (aps *AccountProcessorStub) GetBalanceHistory(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error) {
+	if aps.GetBalanceHistoryCalled != nil {
+		return aps.GetBalanceHistoryCalled(address, options)
+	}
+
+	return &data.AccountBalanceHistory{}, nil
+}
+
+// GetAccountDiff -
+func (aps *AccountProcessorStub) GetAccountDiff(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	if aps.GetAccountDiffCalled != nil {
+		return aps.GetAccountDiffCalled(address, options)
+	}
+
+	return &data.AccountDiff{}, nil
+}
+
+// GetDecodedKeyValuePairs -
+func (aps *AccountProcessorStub) GetDecodedKeyValuePairs(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error) {
+	if aps.GetDecodedKeyValuePairsCalled != nil {
+		return aps.GetDecodedKeyValuePairsCalled(address, options)
+	}
+
+	return &data.DecodedKeyValuePairs{}, nil
+}
+
+// GetDecodedValueForKey -
+func (aps *AccountProcessorStub) GetDecodedValueForKey(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error) {
+	if aps.GetDecodedValueForKeyCalled != nil {
+		return aps.GetDecodedValueForKeyCalled(address, key, options)
+	}
+
+	return &data.DecodedStorageEntry{}, nil
+}
+
+// StreamKeys -
+func (aps *AccountProcessorStub) StreamKeys(address string, numKeysPerPage uint, resumeState *data.KeysStreamResumeState, options common.AccountQueryOptions, handler func(page *data.AccountKeysPage) error) error {
+	if aps.StreamKeysCalled != nil {
+		return aps.StreamKeysCalled(address, numKeysPerPage, resumeState, options, handler)
+	}
+
+	return nil
+}
+
 // AuctionList -
 func (aps *AccountProcessorStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
 	return nil, nil
 }
+
+// ConvertBech32AddressesToHex -
+func (aps *AccountProcessorStub) ConvertBech32AddressesToHex(addresses []string) []*data.AddressConversion {
+	if aps.ConvertBech32AddressesToHexCalled != nil {
+		return aps.ConvertBech32AddressesToHexCalled(addresses)
+	}
+
+	return make([]*data.AddressConversion, 0)
+}
+
+// ConvertHexAddressesToBech32 -
+func (aps *AccountProcessorStub) ConvertHexAddressesToBech32(addresses []string) []*data.AddressConversion {
+	if aps.ConvertHexAddressesToBech32Called != nil {
+		return aps.ConvertHexAddressesToBech32Called(addresses)
+	}
+
+	return make([]*data.AddressConversion, 0)
+}
+
+// ComputeShardIDsForAddresses -
+func (aps *AccountProcessorStub) ComputeShardIDsForAddresses(addresses []string) []*data.AddressShardID {
+	if aps.ComputeShardIDsForAddressesCalled != nil {
+		return aps.ComputeShardIDsForAddressesCalled(addresses)
+	}
+
+	return make([]*data.AddressShardID, 0)
+}
+
+// ValidateAddresses -
+func (aps *AccountProcessorStub) ValidateAddresses(addresses []string) []*data.AddressValidation {
+	if aps.ValidateAddressesCalled != nil {
+		return aps.ValidateAddressesCalled(addresses)
+	}
+
+	return make([]*data.AddressValidation, 0)
+}
+
+// ComputeContractAddress -
+func (aps *AccountProcessorStub) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	if aps.ComputeContractAddressCalled != nil {
+		return aps.ComputeContractAddressCalled(deployer, nonce)
+	}
+
+	return &data.ContractAddress{}, nil
+}
@@ -1,21 +1,30 @@
 package mock
 
 import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // BlockProcessorStub -
 type BlockProcessorStub struct {
-	GetBlockByHashCalled                        func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonceCalled                       func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetHyperBlockByHashCalled                   func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonceCalled                  func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetInternalBlockByHashCalled                func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalBlockByNonceCalled               func(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalMiniBlockByHashCalled            func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetBlockByHashCalled                         func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonceCalled                        func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonceCalled                   func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetInternalBlockByHashCalled                 func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByNonceCalled                func(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalMiniBlockByHashCalled             func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalMiniBlockByHashWithoutEpochCalled func(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalStartOfEpochMetaBlockCalled       func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	StreamHyperBlocksCalled                      func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	GetBlockByTimestampCalled                    func(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	StreamBlocksCalled                           func(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error
+	GetHyperBlockByTimestampCalled               func(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetEpochBoundariesCalled                     func(epoch uint32) (*data.EpochBoundariesApiResponse, error)
+	GetEpochsBoundariesCalled                    func(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error)
+	SearchEventsCalled                           func(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error)
 }
 
 func (bps *BlockProcessorStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
@@ -54,6 +63,15 @@ func (bps *BlockProcessorStub) GetInternalBlockByNonce(shardID uint32, nonce uin
 	return bps.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
+// GetInternalMiniBlockByHashWithoutEpoch -
+func (bps *BlockProcessorStub) GetInternalMiniBlockByHashWithoutEpoch(shardID uint32, hash string, hints common.MiniBlockEpochHints, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	if bps.GetInternalMiniBlockByHashWithoutEpochCalled != nil {
+		return bps.GetInternalMiniBlockByHashWithoutEpochCalled(shardID, hash, hints, format)
+	}
+
+	panic("not implemented: GetInternalMiniBlockByHashWithoutEpoch")
+}
+
 // GetInternalMiniBlockByHash -
 func (bps *BlockProcessorStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return bps.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
@@ -78,3 +96,66 @@ func (bps *BlockProcessorStub) GetAlteredAccountsByHash(shardID uint32, hash str
 func (bps *BlockProcessorStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return bps.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
 }
+
+// GetBlockByTimestamp -
+func (bps *BlockProcessorStub) GetBlockByTimestamp(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if bps.GetBlockByTimestampCalled != nil {
+		return bps.GetBlockByTimestampCalled(shardID, timestamp, options)
+	}
+
+	panic("not implemented: GetBlockByTimestamp")
+}
+
+// StreamBlocks -
+func (bps *BlockProcessorStub) StreamBlocks(shardID uint32, fromNonce uint64, toNonce uint64, options common.BlockQueryOptions, handler func(block *api.Block) error) error {
+	if bps.StreamBlocksCalled != nil {
+		return bps.StreamBlocksCalled(shardID, fromNonce, toNonce, options, handler)
+	}
+
+	return nil
+}
+
+// GetHyperBlockByTimestamp -
+func (bps *BlockProcessorStub) GetHyperBlockByTimestamp(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if bps.GetHyperBlockByTimestampCalled != nil {
+		return bps.GetHyperBlockByTimestampCalled(timestamp, options)
+	}
+
+	panic("not implemented: GetHyperBlockByTimestamp")
+}
+
+// StreamHyperBlocks -
+func (bps *BlockProcessorStub) StreamHyperBlocks(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+	if bps.StreamHyperBlocksCalled != nil {
+		return bps.StreamHyperBlocksCalled(fromNonce, toNonce, options, handler)
+	}
+
+	return nil
+}
+
+// GetEpochBoundaries -
+func (bps *BlockProcessorStub) GetEpochBoundaries(epoch uint32) (*data.EpochBoundariesApiResponse, error) {
+	if bps.GetEpochBoundariesCalled != nil {
+		return bps.GetEpochBoundariesCalled(epoch)
+	}
+
+	panic("not implemented: GetEpochBoundaries")
+}
+
+// GetEpochsBoundaries -
+func (bps *BlockProcessorStub) GetEpochsBoundaries(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error) {
+	if bps.GetEpochsBoundariesCalled != nil {
+		return bps.GetEpochsBoundariesCalled(fromEpoch, toEpoch)
+	}
+
+	panic("not implemented: GetEpochsBoundaries")
+}
+
+// SearchEvents -
+func (bps *BlockProcessorStub) SearchEvents(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+	if bps.SearchEventsCalled != nil {
+		return bps.SearchEventsCalled(options)
+	}
+
+	panic("not implemented: SearchEvents")
+}
@@ -7,7 +7,8 @@ import (
 
 // BlocksProcessorStub -
 type BlocksProcessorStub struct {
-	GetBlocksByRoundCalled func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundCalled    func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	StreamBlocksByRoundCalled func(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error
 }
 
 // GetBlocksByRound -
@@ -17,3 +18,12 @@ func (bps *BlocksProcessorStub) GetBlocksByRound(round uint64, options common.Bl
 	}
 	return nil, nil
 }
+
+// StreamBlocksByRound -
+func (bps *BlocksProcessorStub) StreamBlocksByRound(fromRound uint64, toRound uint64, options common.BlockQueryOptions, handler func(roundBlocks *data.RoundBlocks) error) error {
+	if bps.StreamBlocksByRoundCalled != nil {
+		return bps.StreamBlocksByRoundCalled(fromRound, toRound, options, handler)
+	}
+
+	return nil
+}
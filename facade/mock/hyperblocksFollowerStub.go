@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// HyperblocksFollowerStub -
+type HyperblocksFollowerStub struct {
+	FollowHyperBlocksCalled func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+}
+
+// FollowHyperBlocks -
+func (stub *HyperblocksFollowerStub) FollowHyperBlocks(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+	if stub.FollowHyperBlocksCalled != nil {
+		return stub.FollowHyperBlocksCalled(ctx, fromNonce, options, handler)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -27,6 +28,14 @@ type TransactionProcessorStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*data.TransactionsPoolNonceGaps, error)
+	ComputeTransactionFeeBreakdownCalled        func(tx *transaction.ApiTransactionResult, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error)
+	ComputeTransactionCostFeeBreakdownCalled    func(tx *data.Transaction, cost *data.TxCostResponseData, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error)
+	DryRunTransactionCalled                     func(tx *data.Transaction) (int, *data.TransactionDryRunResult, error)
+	DryRunMultipleTransactionsCalled            func(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error)
+	ReserveNoncesCalled                         func(sender string, count uint64) (*data.NonceReservation, error)
+	SyncNonceReservationsCalled                 func(sender string) (*data.NonceReservationSync, error)
+	DecodeTransactionEventsCalled               func(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents
+	DecodeHyperblockEventsCalled                func(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents
 }
 
 // SimulateTransaction -
@@ -38,6 +47,24 @@ func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, c
 	return nil, errNotImplemented
 }
 
+// DryRunTransaction -
+func (tps *TransactionProcessorStub) DryRunTransaction(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+	if tps.DryRunTransactionCalled != nil {
+		return tps.DryRunTransactionCalled(tx)
+	}
+
+	return 0, nil, errNotImplemented
+}
+
+// DryRunMultipleTransactions -
+func (tps *TransactionProcessorStub) DryRunMultipleTransactions(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error) {
+	if tps.DryRunMultipleTransactionsCalled != nil {
+		return tps.DryRunMultipleTransactionsCalled(txs)
+	}
+
+	return nil, errNotImplemented
+}
+
 // SendTransaction -
 func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int, string, error) {
 	if tps.SendTransactionCalled != nil {
@@ -163,3 +190,57 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(sende
 
 	return nil, errNotImplemented
 }
+
+// ComputeTransactionFeeBreakdown -
+func (tps *TransactionProcessorStub) ComputeTransactionFeeBreakdown(tx *transaction.ApiTransactionResult, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error) {
+	if tps.ComputeTransactionFeeBreakdownCalled != nil {
+		return tps.ComputeTransactionFeeBreakdownCalled(tx, networkConfig)
+	}
+
+	return nil, errNotImplemented
+}
+
+// ComputeTransactionCostFeeBreakdown -
+func (tps *TransactionProcessorStub) ComputeTransactionCostFeeBreakdown(tx *data.Transaction, cost *data.TxCostResponseData, networkConfig *data.NetworkConfig) (*data.FeeBreakdown, error) {
+	if tps.ComputeTransactionCostFeeBreakdownCalled != nil {
+		return tps.ComputeTransactionCostFeeBreakdownCalled(tx, cost, networkConfig)
+	}
+
+	return nil, errNotImplemented
+}
+
+// ReserveNonces -
+func (tps *TransactionProcessorStub) ReserveNonces(sender string, count uint64) (*data.NonceReservation, error) {
+	if tps.ReserveNoncesCalled != nil {
+		return tps.ReserveNoncesCalled(sender, count)
+	}
+
+	return nil, errNotImplemented
+}
+
+// SyncNonceReservations -
+func (tps *TransactionProcessorStub) SyncNonceReservations(sender string) (*data.NonceReservationSync, error) {
+	if tps.SyncNonceReservationsCalled != nil {
+		return tps.SyncNonceReservationsCalled(sender)
+	}
+
+	return nil, errNotImplemented
+}
+
+// DecodeTransactionEvents -
+func (tps *TransactionProcessorStub) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents {
+	if tps.DecodeTransactionEventsCalled != nil {
+		return tps.DecodeTransactionEventsCalled(tx)
+	}
+
+	return nil
+}
+
+// DecodeHyperblockEvents -
+func (tps *TransactionProcessorStub) DecodeHyperblockEvents(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents {
+	if tps.DecodeHyperblockEventsCalled != nil {
+		return tps.DecodeHyperblockEventsCalled(hyperblock)
+	}
+
+	return nil
+}
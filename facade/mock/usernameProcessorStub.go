@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// UsernameProcessorStub -
+type UsernameProcessorStub struct {
+	ResolveUsernameCalled       func(username string) (*data.UsernameResolution, error)
+	GetUsernameForAddressCalled func(address string) (*data.AddressUsername, error)
+}
+
+// ResolveUsername -
+func (stub *UsernameProcessorStub) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	if stub.ResolveUsernameCalled != nil {
+		return stub.ResolveUsernameCalled(username)
+	}
+
+	return &data.UsernameResolution{}, nil
+}
+
+// GetUsernameForAddress -
+func (stub *UsernameProcessorStub) GetUsernameForAddress(address string) (*data.AddressUsername, error) {
+	if stub.GetUsernameForAddressCalled != nil {
+		return stub.GetUsernameForAddressCalled(address)
+	}
+
+	return &data.AddressUsername{}, nil
+}
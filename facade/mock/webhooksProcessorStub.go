@@ -0,0 +1,47 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// WebhooksProcessorStub -
+type WebhooksProcessorStub struct {
+	RegisterWebhookCalled      func(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error)
+	GetWebhooksCalled          func(owner string) []*data.WebhookSubscription
+	RemoveWebhookCalled        func(owner string, id string) error
+	GetWebhookDeliveriesCalled func(owner string, id string) ([]*data.WebhookDelivery, error)
+}
+
+// RegisterWebhook -
+func (stub *WebhooksProcessorStub) RegisterWebhook(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+	if stub.RegisterWebhookCalled != nil {
+		return stub.RegisterWebhookCalled(owner, request)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetWebhooks -
+func (stub *WebhooksProcessorStub) GetWebhooks(owner string) []*data.WebhookSubscription {
+	if stub.GetWebhooksCalled != nil {
+		return stub.GetWebhooksCalled(owner)
+	}
+
+	return nil
+}
+
+// RemoveWebhook -
+func (stub *WebhooksProcessorStub) RemoveWebhook(owner string, id string) error {
+	if stub.RemoveWebhookCalled != nil {
+		return stub.RemoveWebhookCalled(owner, id)
+	}
+
+	return errNotImplemented
+}
+
+// GetWebhookDeliveries -
+func (stub *WebhooksProcessorStub) GetWebhookDeliveries(owner string, id string) ([]*data.WebhookDelivery, error) {
+	if stub.GetWebhookDeliveriesCalled != nil {
+		return stub.GetWebhookDeliveriesCalled(owner, id)
+	}
+
+	return nil, errNotImplemented
+}
@@ -0,0 +1,279 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// accountStateSnapshot holds the state of an account at a given block
+type accountStateSnapshot struct {
+	account *data.AccountModel
+	esdts   map[string]*data.AccountESDTToken
+	pairs   map[string]string
+}
+
+// GetAccountDiff returns what changed in the state of an account between two blocks: the balance and nonce deltas,
+// the ESDT tokens that were added, removed or changed and, optionally, the storage keys that changed.
+// Both states are fetched concurrently from the observers holding the historical state
+func (ap *AccountProcessor) GetAccountDiff(address string, options common.AccountDiffOptions) (*data.AccountDiff, error) {
+	if options.FromBlockNonce >= options.ToBlockNonce {
+		return nil, ErrInvalidAccountDiffRange
+	}
+
+	fromOptions := common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: options.FromBlockNonce, HasValue: true}}
+	toOptions := common.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: options.ToBlockNonce, HasValue: true}}
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(fromOptions)
+	observers, err := ap.getObserversForAddress(address, availability, core.OptionalUint32{})
+	if err != nil {
+		return nil, err
+	}
+	if len(observers) == 0 {
+		return nil, ErrMissingObserver
+	}
+
+	finalNonce := ap.getHighestFinalNonce(observers[0].ShardId)
+
+	snapshots := make([]*accountStateSnapshot, 2)
+	errs := make([]error, 2)
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	for idx, snapshotOptions := range []common.AccountQueryOptions{fromOptions, toOptions} {
+		go func(idx int, snapshotOptions common.AccountQueryOptions) {
+			defer wg.Done()
+
+			snapshots[idx], errs[idx] = ap.getAccountStateSnapshot(observers, address, snapshotOptions, options.WithKeys, finalNonce)
+		}(idx, snapshotOptions)
+	}
+	wg.Wait()
+
+	for _, errSnapshot := range errs {
+		if errSnapshot != nil {
+			return nil, errSnapshot
+		}
+	}
+
+	diff, err := computeAccountDiff(snapshots[0], snapshots[1])
+	if err != nil {
+		return nil, err
+	}
+	diff.Address = address
+
+	log.Info("account diff request",
+		"address", address,
+		"from block nonce", options.FromBlockNonce,
+		"to block nonce", options.ToBlockNonce,
+		"with keys", options.WithKeys)
+
+	return diff, nil
+}
+
+func (ap *AccountProcessor) getAccountStateSnapshot(
+	observers []*data.NodeData,
+	address string,
+	options common.AccountQueryOptions,
+	withKeys bool,
+	finalNonce core.OptionalUint64,
+) (*accountStateSnapshot, error) {
+	account, err := ap.getHistoricalAccount(observers, address, options, finalNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtsResponse := data.AccountESDTTokensApiResponse{}
+	esdtsPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/esdt", options)
+	err = ap.callGetRestEndPointOnObservers(observers, esdtsPath, &esdtsResponse, &esdtsResponse.Error)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &accountStateSnapshot{
+		account: account,
+		esdts:   esdtsResponse.Data.ESDTs,
+	}
+	if !withKeys {
+		return snapshot, nil
+	}
+
+	pairsResponse := data.AccountKeyValuePairsApiResponse{}
+	pairsPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/keys", options)
+	err = ap.callGetRestEndPointOnObservers(observers, pairsPath, &pairsResponse, &pairsResponse.Error)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.pairs = pairsResponse.Data.Pairs
+
+	return snapshot, nil
+}
+
+// callGetRestEndPointOnObservers sends the request to the provided observers, one by one, until one of them answers
+func (ap *AccountProcessor) callGetRestEndPointOnObservers(
+	observers []*data.NodeData,
+	apiPath string,
+	response interface{},
+	responseError *string,
+) error {
+	for _, observer := range observers {
+		_, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, response)
+		if err == nil {
+			return nil
+		}
+
+		log.Error("account request", "observer", observer.Address, "path", apiPath, "error", err.Error())
+	}
+
+	return fmt.Errorf("%w for %s", WrapObserversError(*responseError), apiPath)
+}
+
+func computeAccountDiff(before *accountStateSnapshot, after *accountStateSnapshot) (*data.AccountDiff, error) {
+	balanceBefore, balanceAfter := before.account.Account.Balance, after.account.Account.Balance
+	balanceDelta, err := computeBalanceDelta(balanceBefore, balanceAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceBefore, nonceAfter := before.account.Account.Nonce, after.account.Account.Nonce
+	diff := &data.AccountDiff{
+		FromBlockInfo:   before.account.BlockInfo,
+		ToBlockInfo:     after.account.BlockInfo,
+		BalanceBefore:   balanceBefore,
+		BalanceAfter:    balanceAfter,
+		BalanceDelta:    balanceDelta,
+		NonceBefore:     nonceBefore,
+		NonceAfter:      nonceAfter,
+		NonceDelta:      int64(nonceAfter) - int64(nonceBefore),
+		CodeHashChanged: !bytes.Equal(before.account.Account.CodeHash, after.account.Account.CodeHash),
+		TokensAdded:     make([]*data.ESDTTokenDiff, 0),
+		TokensRemoved:   make([]*data.ESDTTokenDiff, 0),
+		TokensChanged:   make([]*data.ESDTTokenDiff, 0),
+	}
+
+	err = addTokensDiff(diff, before.esdts, after.esdts)
+	if err != nil {
+		return nil, err
+	}
+
+	if before.pairs != nil || after.pairs != nil {
+		diff.Storage = computeStorageDiff(before.pairs, after.pairs)
+	}
+
+	return diff, nil
+}
+
+// addTokensDiff fills the added, removed and changed tokens of the diff, sorted by token identifier
+func addTokensDiff(diff *data.AccountDiff, before map[string]*data.AccountESDTToken, after map[string]*data.AccountESDTToken) error {
+	tokenIdentifiers := make(map[string]struct{})
+	for tokenIdentifier := range before {
+		tokenIdentifiers[tokenIdentifier] = struct{}{}
+	}
+	for tokenIdentifier := range after {
+		tokenIdentifiers[tokenIdentifier] = struct{}{}
+	}
+
+	sortedIdentifiers := make([]string, 0, len(tokenIdentifiers))
+	for tokenIdentifier := range tokenIdentifiers {
+		sortedIdentifiers = append(sortedIdentifiers, tokenIdentifier)
+	}
+	sort.Strings(sortedIdentifiers)
+
+	for _, tokenIdentifier := range sortedIdentifiers {
+		tokenBefore, existedBefore := before[tokenIdentifier]
+		tokenAfter, existsAfter := after[tokenIdentifier]
+		tokenDiff := &data.ESDTTokenDiff{
+			TokenIdentifier: tokenIdentifier,
+			BalanceBefore:   getTokenBalance(tokenBefore),
+			BalanceAfter:    getTokenBalance(tokenAfter),
+		}
+
+		var err error
+		tokenDiff.BalanceDelta, err = computeBalanceDelta(tokenDiff.BalanceBefore, tokenDiff.BalanceAfter)
+		if err != nil {
+			return fmt.Errorf("%w for token %s", err, tokenIdentifier)
+		}
+
+		switch {
+		case !existedBefore:
+			diff.TokensAdded = append(diff.TokensAdded, tokenDiff)
+		case !existsAfter:
+			diff.TokensRemoved = append(diff.TokensRemoved, tokenDiff)
+		case tokenDiff.BalanceDelta != zeroBalance:
+			diff.TokensChanged = append(diff.TokensChanged, tokenDiff)
+		}
+	}
+
+	return nil
+}
+
+func getTokenBalance(token *data.AccountESDTToken) string {
+	if token == nil || len(token.Balance) == 0 {
+		return zeroBalance
+	}
+
+	return token.Balance
+}
+
+// computeBalanceDelta returns the signed difference between the two balances, as a base 10 string
+func computeBalanceDelta(balanceBefore string, balanceAfter string) (string, error) {
+	before, err := parseBalance(balanceBefore)
+	if err != nil {
+		return "", err
+	}
+
+	after, err := parseBalance(balanceAfter)
+	if err != nil {
+		return "", err
+	}
+
+	return big.NewInt(0).Sub(after, before).String(), nil
+}
+
+func parseBalance(balance string) (*big.Int, error) {
+	if len(balance) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	value, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBalance, balance)
+	}
+
+	return value, nil
+}
+
+func computeStorageDiff(before map[string]string, after map[string]string) *data.AccountStorageDiff {
+	storageDiff := &data.AccountStorageDiff{
+		Added:   make(map[string]string),
+		Removed: make(map[string]string),
+		Changed: make(map[string]*data.StorageValueDiff),
+	}
+
+	for key, valueBefore := range before {
+		valueAfter, found := after[key]
+		if !found {
+			storageDiff.Removed[key] = valueBefore
+			continue
+		}
+
+		if valueAfter != valueBefore {
+			storageDiff.Changed[key] = &data.StorageValueDiff{
+				ValueBefore: valueBefore,
+				ValueAfter:  valueAfter,
+			}
+		}
+	}
+
+	for key, valueAfter := range after {
+		_, found := before[key]
+		if !found {
+			storageDiff.Added[key] = valueAfter
+		}
+	}
+
+	return storageDiff
+}
@@ -0,0 +1,174 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountProcessorForDiff(
+	accounts map[string]data.Account,
+	esdts map[string]map[string]*data.AccountESDTToken,
+	pairs map[string]map[string]string,
+) *process.AccountProcessor {
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				blockNonce := path[strings.Index(path, "blockNonce=")+len("blockNonce="):]
+				switch response := value.(type) {
+				case *data.AccountApiResponse:
+					response.Data.Account = accounts[blockNonce]
+					response.Data.BlockInfo = data.BlockInfo{Hash: "hash" + blockNonce}
+				case *data.AccountESDTTokensApiResponse:
+					response.Data.ESDTs = esdts[blockNonce]
+				case *data.AccountKeyValuePairsApiResponse:
+					response.Data.Pairs = pairs[blockNonce]
+				default:
+					return http.StatusNotFound, errors.New("unexpected request")
+				}
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+	)
+
+	return ap
+}
+
+func TestAccountProcessor_GetAccountDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForDiff(nil, nil, nil)
+
+		diff, err := ap.GetAccountDiff("DEADBEEF", common.AccountDiffOptions{FromBlockNonce: 10, ToBlockNonce: 10})
+		require.Nil(t, diff)
+		require.Equal(t, process.ErrInvalidAccountDiffRange, err)
+	})
+	t.Run("failing request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if strings.Contains(path, "/esdt") {
+						return http.StatusInternalServerError, errors.New("esdt error")
+					}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		diff, err := ap.GetAccountDiff("DEADBEEF", common.AccountDiffOptions{FromBlockNonce: 10, ToBlockNonce: 20})
+		require.Nil(t, diff)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForDiff(
+			map[string]data.Account{"10": {Balance: "not a number"}, "20": {Balance: "1"}},
+			nil,
+			nil,
+		)
+
+		diff, err := ap.GetAccountDiff("DEADBEEF", common.AccountDiffOptions{FromBlockNonce: 10, ToBlockNonce: 20})
+		require.Nil(t, diff)
+		require.True(t, errors.Is(err, process.ErrInvalidBalance))
+	})
+	t.Run("should compute the diff", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForDiff(
+			map[string]data.Account{
+				"10": {Balance: "1000", Nonce: 5, CodeHash: []byte("old code")},
+				"20": {Balance: "400", Nonce: 8, CodeHash: []byte("new code")},
+			},
+			map[string]map[string]*data.AccountESDTToken{
+				"10": {
+					"MEX-abcdef":   {TokenIdentifier: "MEX-abcdef", Balance: "100"},
+					"USDC-abcdef":  {TokenIdentifier: "USDC-abcdef", Balance: "7"},
+					"WEGLD-abcdef": {TokenIdentifier: "WEGLD-abcdef", Balance: "3"},
+				},
+				"20": {
+					"MEX-abcdef":   {TokenIdentifier: "MEX-abcdef", Balance: "150"},
+					"RIDE-abcdef":  {TokenIdentifier: "RIDE-abcdef", Balance: "9"},
+					"WEGLD-abcdef": {TokenIdentifier: "WEGLD-abcdef", Balance: "3"},
+				},
+			},
+			map[string]map[string]string{
+				"10": {"aa": "01", "bb": "02", "cc": "03"},
+				"20": {"aa": "01", "bb": "05", "dd": "04"},
+			},
+		)
+
+		diff, err := ap.GetAccountDiff("DEADBEEF", common.AccountDiffOptions{FromBlockNonce: 10, ToBlockNonce: 20, WithKeys: true})
+		require.Nil(t, err)
+		require.Equal(t, &data.AccountDiff{
+			Address:         "DEADBEEF",
+			FromBlockInfo:   data.BlockInfo{Hash: "hash10"},
+			ToBlockInfo:     data.BlockInfo{Hash: "hash20"},
+			BalanceBefore:   "1000",
+			BalanceAfter:    "400",
+			BalanceDelta:    "-600",
+			NonceBefore:     5,
+			NonceAfter:      8,
+			NonceDelta:      3,
+			CodeHashChanged: true,
+			TokensAdded: []*data.ESDTTokenDiff{
+				{TokenIdentifier: "RIDE-abcdef", BalanceBefore: "0", BalanceAfter: "9", BalanceDelta: "9"},
+			},
+			TokensRemoved: []*data.ESDTTokenDiff{
+				{TokenIdentifier: "USDC-abcdef", BalanceBefore: "7", BalanceAfter: "0", BalanceDelta: "-7"},
+			},
+			TokensChanged: []*data.ESDTTokenDiff{
+				{TokenIdentifier: "MEX-abcdef", BalanceBefore: "100", BalanceAfter: "150", BalanceDelta: "50"},
+			},
+			Storage: &data.AccountStorageDiff{
+				Added:   map[string]string{"dd": "04"},
+				Removed: map[string]string{"cc": "03"},
+				Changed: map[string]*data.StorageValueDiff{"bb": {ValueBefore: "02", ValueAfter: "05"}},
+			},
+		}, diff)
+	})
+	t.Run("without keys should not fetch the storage", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForDiff(
+			map[string]data.Account{"10": {Balance: "1"}, "20": {Balance: "1"}},
+			nil,
+			nil,
+		)
+
+		diff, err := ap.GetAccountDiff("DEADBEEF", common.AccountDiffOptions{FromBlockNonce: 10, ToBlockNonce: 20})
+		require.Nil(t, err)
+		require.Equal(t, "0", diff.BalanceDelta)
+		require.False(t, diff.CodeHashChanged)
+		require.Nil(t, diff.Storage)
+		require.Empty(t, diff.TokensAdded)
+	})
+}
@@ -0,0 +1,248 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	nftAttributesFieldsSeparator   = ";"
+	nftAttributesKeyValueSeparator = ":"
+)
+
+// GetAccountPortfolio returns, in a single call, the account, its ESDT tokens, NFTs and SFTs (with decoded attributes),
+// ESDT roles, guardian data and code hash. The account is fetched first and the rest of the data is then fetched
+// concurrently from the same observer, at the block the account was read at, so the result is consistent
+func (ap *AccountProcessor) GetAccountPortfolio(address string, options common.AccountQueryOptions) (*data.AccountPortfolio, error) {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	lastErrMessage := ""
+	for _, observer := range observers {
+		portfolio, errPortfolio := ap.getAccountPortfolioFromObserver(observer, address, options)
+		if errPortfolio == nil {
+			log.Info("account portfolio",
+				"address", address,
+				"shard ID", observer.ShardId,
+				"observer", observer.Address,
+				"block nonce", portfolio.BlockInfo.Nonce)
+			return portfolio, nil
+		}
+
+		lastErrMessage = errPortfolio.Error()
+		log.Error("account portfolio", "observer", observer.Address, "address", address, "error", lastErrMessage)
+	}
+
+	return nil, WrapObserversError(lastErrMessage)
+}
+
+func (ap *AccountProcessor) getAccountPortfolioFromObserver(
+	observer *data.NodeData,
+	address string,
+	options common.AccountQueryOptions,
+) (*data.AccountPortfolio, error) {
+	accountResponse := data.AccountApiResponse{}
+	accountPath := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	_, err := ap.proc.CallGetRestEndPoint(observer.Address, accountPath, &accountResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	blockInfo := accountResponse.Data.BlockInfo
+	pinnedOptions := createPinnedAccountQueryOptions(options, blockInfo)
+
+	esdtsResponse := data.AccountESDTTokensApiResponse{}
+	guardianResponse := data.AccountGuardianDataApiResponse{}
+	codeHashResponse := data.AccountCodeHashApiResponse{}
+	rolesResponse := data.AccountESDTRolesApiResponse{}
+
+	requests := []struct {
+		path     string
+		response interface{}
+	}{
+		{path: addressPath + address + "/esdt", response: &esdtsResponse},
+		{path: addressPath + address + "/guardian-data", response: &guardianResponse},
+		{path: addressPath + address + "/code-hash", response: &codeHashResponse},
+	}
+
+	errs := make([]error, len(requests)+1)
+	wg := sync.WaitGroup{}
+	wg.Add(len(requests) + 1)
+	for idx, request := range requests {
+		go func(idx int, path string, response interface{}) {
+			defer wg.Done()
+
+			apiPath := common.BuildUrlWithAccountQueryOptions(path, pinnedOptions)
+			_, errs[idx] = ap.proc.CallGetRestEndPoint(observer.Address, apiPath, response)
+		}(idx, request.path, request.response)
+	}
+
+	// the ESDT roles are held by the metachain, so they cannot be fetched at the block of the shard observer. As for
+	// GetESDTsRoles, the requested block coordinates are passed through, so a historical portfolio holds past roles
+	go func() {
+		defer wg.Done()
+
+		errs[len(requests)] = ap.getESDTsRolesForPortfolio(address, options, &rolesResponse)
+	}()
+	wg.Wait()
+
+	for _, errRequest := range errs {
+		if errRequest != nil {
+			return nil, errRequest
+		}
+	}
+
+	fungibleTokens, nonFungibleTokens := splitPortfolioTokens(esdtsResponse.Data.ESDTs)
+	roles := rolesResponse.Data.Roles
+	if roles == nil {
+		roles = make(map[string][]string)
+	}
+
+	return &data.AccountPortfolio{
+		Account:           accountResponse.Data.Account,
+		Username:          accountResponse.Data.Account.Username,
+		CodeHash:          codeHashResponse.Data.CodeHash,
+		FungibleTokens:    fungibleTokens,
+		NonFungibleTokens: nonFungibleTokens,
+		Roles:             roles,
+		GuardianData:      guardianResponse.Data.GuardianData,
+		BlockInfo:         blockInfo,
+	}, nil
+}
+
+func (ap *AccountProcessor) getESDTsRolesForPortfolio(
+	address string,
+	options common.AccountQueryOptions,
+	rolesResponse *data.AccountESDTRolesApiResponse,
+) error {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
+	if err != nil {
+		return err
+	}
+
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/esdts/roles", options)
+	for _, observer := range observers {
+		_, err = ap.proc.CallGetRestEndPoint(observer.Address, apiPath, rolesResponse)
+		if err == nil {
+			return nil
+		}
+
+		log.Error("account portfolio ESDTs roles", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return fmt.Errorf("%w while fetching the ESDTs roles", WrapObserversError(rolesResponse.Error))
+}
+
+// createPinnedAccountQueryOptions returns the options that point to the exact block described by the provided block info.
+// Without a block hash or a nonce in the block info, the requested options are returned unchanged, as a missing nonce
+// would otherwise point to the genesis block
+func createPinnedAccountQueryOptions(options common.AccountQueryOptions, blockInfo data.BlockInfo) common.AccountQueryOptions {
+	pinnedOptions := common.AccountQueryOptions{
+		HintEpoch: options.HintEpoch,
+	}
+
+	blockHash, err := hex.DecodeString(blockInfo.Hash)
+	if err == nil && len(blockHash) > 0 {
+		pinnedOptions.BlockHash = blockHash
+		return pinnedOptions
+	}
+	if blockInfo.Nonce == 0 {
+		return options
+	}
+
+	pinnedOptions.BlockNonce = core.OptionalUint64{
+		Value:    blockInfo.Nonce,
+		HasValue: true,
+	}
+
+	return pinnedOptions
+}
+
+// splitPortfolioTokens separates the fungible tokens from the non-fungible ones, decoding the attributes of the latter.
+// Both slices are sorted by token identifier
+func splitPortfolioTokens(tokens map[string]*data.AccountESDTToken) ([]*data.AccountESDTToken, []*data.AccountESDTToken) {
+	fungibleTokens := make([]*data.AccountESDTToken, 0)
+	nonFungibleTokens := make([]*data.AccountESDTToken, 0)
+	for _, token := range tokens {
+		if token == nil {
+			continue
+		}
+
+		if token.Nonce == 0 {
+			fungibleTokens = append(fungibleTokens, token)
+			continue
+		}
+
+		token.DecodedAttributes = decodeNFTAttributes(token.Attributes)
+		nonFungibleTokens = append(nonFungibleTokens, token)
+	}
+
+	sortTokens := func(tokens []*data.AccountESDTToken) {
+		sort.Slice(tokens, func(i, j int) bool {
+			return tokens[i].TokenIdentifier < tokens[j].TokenIdentifier
+		})
+	}
+	sortTokens(fungibleTokens)
+	sortTokens(nonFungibleTokens)
+
+	return fungibleTokens, nonFungibleTokens
+}
+
+// decodeNFTAttributes returns the human-readable form of the attributes, or nil if the attributes are binary
+func decodeNFTAttributes(attributes []byte) *data.NFTAttributes {
+	if len(attributes) == 0 || !isPrintableText(attributes) {
+		return nil
+	}
+
+	text := string(attributes)
+	decoded := &data.NFTAttributes{
+		Text: text,
+	}
+
+	fields := make(map[string]string)
+	for _, pair := range strings.Split(text, nftAttributesFieldsSeparator) {
+		if len(pair) == 0 {
+			continue
+		}
+
+		keyValue := strings.SplitN(pair, nftAttributesKeyValueSeparator, 2)
+		if len(keyValue) != 2 {
+			return decoded
+		}
+
+		fields[keyValue[0]] = keyValue[1]
+	}
+
+	if len(fields) > 0 {
+		decoded.Fields = fields
+	}
+
+	return decoded
+}
+
+func isPrintableText(buff []byte) bool {
+	if !utf8.Valid(buff) {
+		return false
+	}
+
+	for _, r := range string(buff) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+
+	return true
+}
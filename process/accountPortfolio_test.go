@@ -0,0 +1,233 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountProcessor_GetAccountPortfolio(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get observers fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return nil, expectedErr
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		portfolio, err := ap.GetAccountPortfolio("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, portfolio)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("failing request on all observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if strings.Contains(path, "/guardian-data") {
+						return http.StatusInternalServerError, errors.New("guardian data error")
+					}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		portfolio, err := ap.GetAccountPortfolio("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, portfolio)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.True(t, strings.Contains(err.Error(), "guardian data error"))
+	})
+	t.Run("should fetch everything at the same block from the same observer", func(t *testing.T) {
+		t.Parallel()
+
+		address := "DEADBEEF"
+		blockInfo := data.BlockInfo{Nonce: 37, Hash: "abcd", RootHash: "rootHash"}
+		mutPaths := sync.Mutex{}
+		calledPaths := make(map[string]string)
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					if shardId == core.MetachainShardId {
+						return []*data.NodeData{{Address: "meta observer", ShardId: shardId}}, nil
+					}
+
+					return []*data.NodeData{
+						{Address: "offline observer", ShardId: shardId},
+						{Address: "observer", ShardId: shardId},
+					}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if address == "offline observer" {
+						return http.StatusNotFound, errors.New("offline")
+					}
+
+					mutPaths.Lock()
+					calledPaths[path] = address
+					mutPaths.Unlock()
+
+					switch response := value.(type) {
+					case *data.AccountApiResponse:
+						response.Data.Account = data.Account{Address: address, Nonce: 7, Username: "alice.elrond"}
+						response.Data.BlockInfo = blockInfo
+					case *data.AccountESDTTokensApiResponse:
+						response.Data.ESDTs = map[string]*data.AccountESDTToken{
+							"WEGLD-abcdef":     {TokenIdentifier: "WEGLD-abcdef", Balance: "100"},
+							"MEX-abcdef":       {TokenIdentifier: "MEX-abcdef", Balance: "200"},
+							"NFT-abcdef-01":    {TokenIdentifier: "NFT-abcdef-01", Nonce: 1, Balance: "1", Attributes: []byte("tags:art,music;metadata:cid")},
+							"SFT-abcdef-02":    {TokenIdentifier: "SFT-abcdef-02", Nonce: 2, Balance: "5", Attributes: []byte("plain text")},
+							"BINARY-abcdef-0a": {TokenIdentifier: "BINARY-abcdef-0a", Nonce: 10, Balance: "1", Attributes: []byte{0x00, 0x01, 0xff}},
+						}
+					case *data.AccountGuardianDataApiResponse:
+						response.Data.GuardianData = data.GuardianData{Guarded: true, ActiveGuardian: &data.Guardian{Address: "guardian"}}
+					case *data.AccountCodeHashApiResponse:
+						response.Data.CodeHash = []byte("code hash")
+					case *data.AccountESDTRolesApiResponse:
+						response.Data.Roles = map[string][]string{"MEX-abcdef": {"ESDTRoleLocalMint"}}
+					}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		portfolio, err := ap.GetAccountPortfolio(address, common.AccountQueryOptions{OnFinalBlock: true})
+		require.Nil(t, err)
+
+		require.Equal(t, map[string]string{
+			"/address/DEADBEEF?onFinalBlock=true":             "observer",
+			"/address/DEADBEEF/esdt?blockHash=abcd":           "observer",
+			"/address/DEADBEEF/guardian-data?blockHash=abcd":  "observer",
+			"/address/DEADBEEF/code-hash?blockHash=abcd":      "observer",
+			"/address/DEADBEEF/esdts/roles?onFinalBlock=true": "meta observer",
+		}, calledPaths)
+
+		require.Equal(t, uint64(7), portfolio.Account.Nonce)
+		require.Equal(t, "alice.elrond", portfolio.Username)
+		require.Equal(t, []byte("code hash"), portfolio.CodeHash)
+		require.Equal(t, blockInfo, portfolio.BlockInfo)
+		require.True(t, portfolio.GuardianData.Guarded)
+		require.Equal(t, map[string][]string{"MEX-abcdef": {"ESDTRoleLocalMint"}}, portfolio.Roles)
+
+		require.Len(t, portfolio.FungibleTokens, 2)
+		require.Equal(t, "MEX-abcdef", portfolio.FungibleTokens[0].TokenIdentifier)
+		require.Equal(t, "WEGLD-abcdef", portfolio.FungibleTokens[1].TokenIdentifier)
+
+		require.Len(t, portfolio.NonFungibleTokens, 3)
+		require.Equal(t, "BINARY-abcdef-0a", portfolio.NonFungibleTokens[0].TokenIdentifier)
+		require.Nil(t, portfolio.NonFungibleTokens[0].DecodedAttributes)
+		require.Equal(t, &data.NFTAttributes{
+			Text:   "tags:art,music;metadata:cid",
+			Fields: map[string]string{"tags": "art,music", "metadata": "cid"},
+		}, portfolio.NonFungibleTokens[1].DecodedAttributes)
+		require.Equal(t, &data.NFTAttributes{Text: "plain text"}, portfolio.NonFungibleTokens[2].DecodedAttributes)
+	})
+}
+
+func TestAccountProcessor_GetAccountPortfolioHistoricalRoles(t *testing.T) {
+	t.Parallel()
+
+	mutPaths := sync.Mutex{}
+	rolesPath := ""
+	metaAvailability := data.AvailabilityRecent
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if shardId == core.MetachainShardId {
+					metaAvailability = dataAvailability
+				}
+
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				switch response := value.(type) {
+				case *data.AccountApiResponse:
+					response.Data.BlockInfo = data.BlockInfo{Nonce: 37, Hash: "abcd"}
+				case *data.AccountESDTRolesApiResponse:
+					mutPaths.Lock()
+					rolesPath = path
+					mutPaths.Unlock()
+				}
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+	)
+
+	options := common.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: 37, HasValue: true},
+		HintEpoch:  core.OptionalUint32{Value: 3, HasValue: true},
+	}
+	_, err := ap.GetAccountPortfolio("DEADBEEF", options)
+	require.Nil(t, err)
+	require.Equal(t, "/address/DEADBEEF/esdts/roles?blockNonce=37&hintEpoch=3", rolesPath)
+	require.Equal(t, data.AvailabilityAll, metaAvailability)
+}
+
+func TestAccountProcessor_GetAccountPortfolioWithoutBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	mutPaths := sync.Mutex{}
+	requestedPaths := make([]string, 0)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				mutPaths.Lock()
+				requestedPaths = append(requestedPaths, path)
+				mutPaths.Unlock()
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+	)
+
+	_, err := ap.GetAccountPortfolio("DEADBEEF", common.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Len(t, requestedPaths, 5)
+	for _, path := range requestedPaths {
+		require.False(t, strings.Contains(path, "blockNonce"), path)
+		require.False(t, strings.Contains(path, "blockHash"), path)
+	}
+}
@@ -5,22 +5,33 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"sync/atomic"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/availabilityCommon"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
 )
 
 // addressPath defines the address path at which the nodes answer
 const addressPath = "/address/"
 
+// maxConcurrentESDTRequestsPerShard defines how many ESDT requests are sent at the same time to the observers of a shard
+const maxConcurrentESDTRequestsPerShard = 20
+
+const zeroBalance = "0"
+
+// historicalStateCacheCapacity defines how many historical account states and ESDT balances are kept in memory
+const historicalStateCacheCapacity = 100000
+
 // AccountProcessor is able to process account requests
 type AccountProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	historicalStateCache ImmutableDataCacheHandler
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
@@ -32,10 +43,16 @@ func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (
 		return nil, ErrNilPubKeyConverter
 	}
 
+	historicalStateCache, err := cache.NewLRUCache(historicalStateCacheCapacity)
+	if err != nil {
+		return nil, err
+	}
+
 	return &AccountProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		historicalStateCache: historicalStateCache,
 	}, nil
 }
 
@@ -153,6 +170,211 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 	return nil, ErrSendingRequest
 }
 
+// GetESDTBalancesForAccounts returns the ESDT balances of the provided accounts. The addresses are grouped by shard and
+// the shards are queried concurrently. If token identifiers are provided, only the balances of those tokens are returned,
+// the missing ones being reported with a zero balance
+func (ap *AccountProcessor) GetESDTBalancesForAccounts(
+	addresses []string,
+	tokens []string,
+	options common.AccountQueryOptions,
+) (*data.AccountsESDTBalances, error) {
+	addressesInShards := make(map[uint32][]string)
+	for _, address := range addresses {
+		shardID, err := ap.GetShardIDForAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w while trying to compute shard ID of address %s", err, address)
+		}
+
+		addressesInShards[shardID] = append(addressesInShards[shardID], address)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(addressesInShards))
+
+	var shardErr error
+	var mut sync.Mutex // Mutex to protect the shared map and error
+	var failed atomic.Bool
+	balancesResponse := make(map[string]map[string]string)
+
+	for shID, addressesInShard := range addressesInShards {
+		go func(shID uint32, addressesInShard []string) {
+			defer wg.Done()
+			balancesInShard, errGetBalances := ap.getESDTBalancesInShard(addressesInShard, shID, tokens, options, &failed)
+
+			mut.Lock()
+			defer mut.Unlock()
+
+			if errGetBalances != nil {
+				// the shards aborted because of a failure elsewhere should not hide that failure
+				if shardErr == nil || errors.Is(shardErr, ErrESDTBalancesRequestAborted) {
+					shardErr = errGetBalances
+				}
+				return
+			}
+
+			for address, balances := range balancesInShard {
+				balancesResponse[address] = balances
+			}
+		}(shID, addressesInShard)
+	}
+
+	wg.Wait()
+
+	if shardErr != nil {
+		return nil, shardErr
+	}
+
+	return &data.AccountsESDTBalances{
+		Balances: balancesResponse,
+	}, nil
+}
+
+// getESDTBalancesInShard fetches the ESDT balances of the provided addresses of a shard. Once a request fails, in this
+// shard or in another one sharing the failed flag, no new request is sent
+func (ap *AccountProcessor) getESDTBalancesInShard(
+	addresses []string,
+	shardID uint32,
+	tokens []string,
+	options common.AccountQueryOptions,
+	failed *atomic.Bool,
+) (map[string]map[string]string, error) {
+	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var requestErr error
+	balances := make(map[string]map[string]string, len(addresses))
+	throttler := make(chan struct{}, maxConcurrentESDTRequestsPerShard)
+	for _, address := range addresses {
+		throttler <- struct{}{}
+		if failed.Load() {
+			<-throttler
+			break
+		}
+
+		wg.Add(1)
+		go func(address string) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			balancesOfAddress, errGet := ap.getESDTBalancesFromObservers(observers, address, tokens, options)
+
+			mut.Lock()
+			defer mut.Unlock()
+
+			if errGet != nil {
+				requestErr = fmt.Errorf("%w while fetching the ESDT balances of address %s", errGet, address)
+				failed.Store(true)
+				return
+			}
+
+			balances[address] = balancesOfAddress
+		}(address)
+	}
+
+	wg.Wait()
+
+	if requestErr != nil {
+		return nil, requestErr
+	}
+	if failed.Load() {
+		return nil, ErrESDTBalancesRequestAborted
+	}
+
+	log.Info("bulk ESDT balances request", "shard ID", shardID, "num addresses", len(addresses))
+
+	return balances, nil
+}
+
+// getESDTBalancesFromObservers returns all the ESDT balances of the address or, when tokens are provided, only the
+// balances of those tokens, requested one by one
+func (ap *AccountProcessor) getESDTBalancesFromObservers(
+	observers []*data.NodeData,
+	address string,
+	tokens []string,
+	options common.AccountQueryOptions,
+) (map[string]string, error) {
+	if len(tokens) == 0 {
+		return ap.getAllESDTBalancesFromObservers(observers, address, options)
+	}
+
+	balances := make(map[string]string, len(tokens))
+	for _, tokenIdentifier := range tokens {
+		balance, err := ap.getESDTBalanceFromObservers(observers, address, tokenIdentifier, options)
+		if err != nil {
+			return nil, err
+		}
+
+		balances[tokenIdentifier] = balance
+	}
+
+	return balances, nil
+}
+
+func (ap *AccountProcessor) getAllESDTBalancesFromObservers(
+	observers []*data.NodeData,
+	address string,
+	options common.AccountQueryOptions,
+) (map[string]string, error) {
+	apiResponse := data.AccountESDTTokensApiResponse{}
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/esdt", options)
+	for _, observer := range observers {
+		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			if apiResponse.Error != "" {
+				return nil, errors.New(apiResponse.Error)
+			}
+
+			balances := make(map[string]string, len(apiResponse.Data.ESDTs))
+			for tokenIdentifier, token := range apiResponse.Data.ESDTs {
+				if token != nil {
+					balances[tokenIdentifier] = token.Balance
+				}
+			}
+
+			return balances, nil
+		}
+
+		log.Error("bulk ESDT balances request", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, ErrSendingRequest
+}
+
+func (ap *AccountProcessor) getESDTBalanceFromObservers(
+	observers []*data.NodeData,
+	address string,
+	tokenIdentifier string,
+	options common.AccountQueryOptions,
+) (string, error) {
+	apiResponse := data.AccountESDTTokenDataApiResponse{}
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/esdt/"+tokenIdentifier, options)
+	for _, observer := range observers {
+		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			if apiResponse.Error != "" {
+				return "", errors.New(apiResponse.Error)
+			}
+
+			balance := apiResponse.Data.TokenData.Balance
+			if len(balance) == 0 {
+				balance = zeroBalance
+			}
+
+			return balance, nil
+		}
+
+		log.Error("bulk ESDT balances request", "observer", observer.Address, "address", address, "token", tokenIdentifier, "error", err.Error())
+	}
+
+	return "", ErrSendingRequest
+}
+
 // GetValueForKey returns the value for the given address and key
 func (ap *AccountProcessor) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
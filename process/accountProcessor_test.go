@@ -3,8 +3,12 @@ package process_test
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
@@ -626,6 +630,131 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 	})
 }
 
+func TestAccountProcessor_GetESDTBalancesForAccounts(t *testing.T) {
+	t.Parallel()
+
+	createProcessorStub := func(failingAddress string, numRequests *uint32) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{
+						Address: fmt.Sprintf("observer%d", shardID),
+						ShardId: shardID,
+					},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(obsAddr string, path string, value interface{}) (int, error) {
+				atomic.AddUint32(numRequests, 1)
+				time.Sleep(time.Millisecond)
+				esdts := map[string]*data.AccountESDTToken{
+					"MEX-abcdef":   {TokenIdentifier: "MEX-abcdef", Balance: obsAddr},
+					"WEGLD-abcdef": {TokenIdentifier: "WEGLD-abcdef", Balance: "37"},
+				}
+
+				switch response := value.(type) {
+				case *data.AccountESDTTokensApiResponse:
+					if strings.Contains(path, failingAddress) {
+						response.Error = "expected error message"
+						return http.StatusInternalServerError, errors.New("internal error")
+					}
+
+					response.Data.ESDTs = esdts
+				case *data.AccountESDTTokenDataApiResponse:
+					if strings.Contains(path, failingAddress) {
+						response.Error = "expected error message"
+						return http.StatusInternalServerError, errors.New("internal error")
+					}
+
+					tokenIdentifier := path[strings.LastIndex(path, "/")+1:]
+					token, found := esdts[tokenIdentifier]
+					if found {
+						response.Data.TokenData = *token
+					}
+				}
+				return http.StatusOK, nil
+			},
+			ComputeShardIdCalled: func(addr []byte) (uint32, error) {
+				if hex.EncodeToString(addr) == "aabb" {
+					return 0, nil
+				}
+
+				return 1, nil
+			},
+		}
+	}
+
+	t.Run("should return error if an address request fails", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := uint32(0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub("bbaa", &numRequests), &mock.PubKeyConverterMock{})
+
+		result, err := ap.GetESDTBalancesForAccounts([]string{"aabb", "bbaa"}, nil, common.AccountQueryOptions{})
+		require.Nil(t, result)
+		require.True(t, strings.Contains(err.Error(), "expected error message"))
+		require.True(t, strings.Contains(err.Error(), "bbaa"))
+	})
+	t.Run("should return all the balances", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := uint32(0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub("none", &numRequests), &mock.PubKeyConverterMock{})
+
+		result, err := ap.GetESDTBalancesForAccounts([]string{"aabb", "bbaa"}, nil, common.AccountQueryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, map[string]map[string]string{
+			"aabb": {"MEX-abcdef": "observer0", "WEGLD-abcdef": "37"},
+			"bbaa": {"MEX-abcdef": "observer1", "WEGLD-abcdef": "37"},
+		}, result.Balances)
+	})
+	t.Run("should filter the balances by token", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := uint32(0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub("none", &numRequests), &mock.PubKeyConverterMock{})
+
+		result, err := ap.GetESDTBalancesForAccounts([]string{"aabb", "bbaa"}, []string{"WEGLD-abcdef", "USDC-abcdef"}, common.AccountQueryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, map[string]map[string]string{
+			"aabb": {"WEGLD-abcdef": "37", "USDC-abcdef": "0"},
+			"bbaa": {"WEGLD-abcdef": "37", "USDC-abcdef": "0"},
+		}, result.Balances)
+		// one request per address and token, instead of the full tokens list
+		require.Equal(t, uint32(4), atomic.LoadUint32(&numRequests))
+	})
+	t.Run("failed request should stop sending new ones", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := uint32(0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub("0000", &numRequests), &mock.PubKeyConverterMock{})
+
+		addresses := make([]string, 0, 500)
+		for i := 0; i < 500; i++ {
+			addresses = append(addresses, fmt.Sprintf("%04x", i))
+		}
+
+		result, err := ap.GetESDTBalancesForAccounts(addresses, nil, common.AccountQueryOptions{})
+		require.Nil(t, result)
+		require.True(t, strings.Contains(err.Error(), "expected error message"))
+		require.Less(t, atomic.LoadUint32(&numRequests), uint32(len(addresses)))
+	})
+	t.Run("many addresses should work", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := uint32(0)
+		ap, _ := process.NewAccountProcessor(createProcessorStub("none", &numRequests), &mock.PubKeyConverterMock{})
+
+		addresses := make([]string, 0, 500)
+		for i := 0; i < 500; i++ {
+			addresses = append(addresses, fmt.Sprintf("%04x", i))
+		}
+
+		result, err := ap.GetESDTBalancesForAccounts(addresses, []string{"WEGLD-abcdef"}, common.AccountQueryOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Balances, len(addresses))
+	})
+}
+
 func TestAccountProcessor_IterateKeys(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,150 @@
+package process
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// wasmVMType is the type of the virtual machine running the smart contracts deployed by users
+var wasmVMType = []byte{5, 0}
+
+// ConvertBech32AddressesToHex converts the provided bech32 addresses to their hex representation. An address that
+// cannot be converted does not fail the whole bulk, its error being reported next to it
+func (ap *AccountProcessor) ConvertBech32AddressesToHex(addresses []string) []*data.AddressConversion {
+	conversions := make([]*data.AddressConversion, 0, len(addresses))
+	for _, address := range addresses {
+		conversion := &data.AddressConversion{Input: address}
+		addressBytes, err := ap.pubKeyConverter.Decode(address)
+		if err != nil {
+			conversion.Error = err.Error()
+		} else {
+			conversion.Output = hex.EncodeToString(addressBytes)
+		}
+
+		conversions = append(conversions, conversion)
+	}
+
+	return conversions
+}
+
+// ConvertHexAddressesToBech32 converts the provided hex addresses to their bech32 representation. An address that
+// cannot be converted does not fail the whole bulk, its error being reported next to it
+func (ap *AccountProcessor) ConvertHexAddressesToBech32(addresses []string) []*data.AddressConversion {
+	conversions := make([]*data.AddressConversion, 0, len(addresses))
+	for _, address := range addresses {
+		conversion := &data.AddressConversion{Input: address}
+		output, err := ap.encodeHexAddress(address)
+		if err != nil {
+			conversion.Error = err.Error()
+		} else {
+			conversion.Output = output
+		}
+
+		conversions = append(conversions, conversion)
+	}
+
+	return conversions
+}
+
+func (ap *AccountProcessor) encodeHexAddress(address string) (string, error) {
+	addressBytes, err := hex.DecodeString(address)
+	if err != nil {
+		return "", err
+	}
+	if len(addressBytes) != ap.pubKeyConverter.Len() {
+		return "", fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddressLength, ap.pubKeyConverter.Len(), len(addressBytes))
+	}
+
+	return ap.pubKeyConverter.Encode(addressBytes)
+}
+
+// ComputeShardIDsForAddresses returns the shard ID of each of the provided addresses, based on the current proxy's
+// configuration
+func (ap *AccountProcessor) ComputeShardIDsForAddresses(addresses []string) []*data.AddressShardID {
+	shardIDs := make([]*data.AddressShardID, 0, len(addresses))
+	for _, address := range addresses {
+		addressShardID := &data.AddressShardID{Address: address}
+		shardID, err := ap.GetShardIDForAddress(address)
+		if err != nil {
+			addressShardID.Error = err.Error()
+		} else {
+			addressShardID.ShardID = &shardID
+		}
+
+		shardIDs = append(shardIDs, addressShardID)
+	}
+
+	return shardIDs
+}
+
+// ValidateAddresses checks that each of the provided addresses has the configured human readable part and length
+func (ap *AccountProcessor) ValidateAddresses(addresses []string) []*data.AddressValidation {
+	validations := make([]*data.AddressValidation, 0, len(addresses))
+	for _, address := range addresses {
+		validation := &data.AddressValidation{Address: address}
+		_, err := ap.pubKeyConverter.Decode(address)
+		if err != nil {
+			validation.Error = err.Error()
+		} else {
+			validation.IsValid = true
+		}
+
+		validations = append(validations, validation)
+	}
+
+	return validations
+}
+
+// ComputeContractAddress returns the deterministic address of the smart contract deployed by the provided sender,
+// with the provided nonce
+func (ap *AccountProcessor) ComputeContractAddress(deployer string, nonce uint64) (*data.ContractAddress, error) {
+	deployerBytes, err := ap.pubKeyConverter.Decode(deployer)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployerBytes) != ap.pubKeyConverter.Len() || len(deployerBytes) <= core.NumInitCharactersForScAddress+core.ShardIdentiferLen {
+		return nil, ErrInvalidAddressLength
+	}
+
+	contractAddressBytes := computeContractAddress(deployerBytes, nonce)
+	contractAddress, err := ap.pubKeyConverter.Encode(contractAddressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := ap.proc.ComputeShardId(contractAddressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ContractAddress{
+		Address: contractAddress,
+		Hex:     hex.EncodeToString(contractAddressBytes),
+		ShardID: shardID,
+	}, nil
+}
+
+// computeContractAddress applies the same algorithm as the protocol: the address is the keccak hash of the deployer
+// address and its little endian encoded nonce, starting with the VM type prefixed by zeros and ending with the last
+// bytes of the deployer address, so that the contract lives in the same shard as its deployer
+func computeContractAddress(deployer []byte, nonce uint64) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, nonce)
+
+	hashInput := make([]byte, 0, len(deployer)+len(nonceBytes))
+	hashInput = append(hashInput, deployer...)
+	hashInput = append(hashInput, nonceBytes...)
+	contractAddress := keccak.NewKeccak().Compute(string(hashInput))
+
+	prefix := make([]byte, core.NumInitCharactersForScAddress-core.VMTypeLen, core.NumInitCharactersForScAddress)
+	prefix = append(prefix, wasmVMType...)
+	copy(contractAddress[:core.NumInitCharactersForScAddress], prefix)
+	copy(contractAddress[len(contractAddress)-core.ShardIdentiferLen:], deployer[len(deployer)-core.ShardIdentiferLen:])
+
+	return contractAddress
+}
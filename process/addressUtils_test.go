@@ -0,0 +1,148 @@
+package process_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	aliceBech32 = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	aliceHex    = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+)
+
+func createAccountProcessorForAddressUtils(t *testing.T) *process.AccountProcessor {
+	ap, err := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return uint32(addressBuff[len(addressBuff)-1] % 3), nil
+			},
+		},
+		testPubkeyConverter,
+	)
+	require.Nil(t, err)
+
+	return ap
+}
+
+func TestAccountProcessor_ConvertBech32AddressesToHex(t *testing.T) {
+	t.Parallel()
+
+	ap := createAccountProcessorForAddressUtils(t)
+	conversions := ap.ConvertBech32AddressesToHex([]string{aliceBech32, "invalid"})
+	require.Len(t, conversions, 2)
+	require.Equal(t, &data.AddressConversion{Input: aliceBech32, Output: aliceHex}, conversions[0])
+	require.Equal(t, "invalid", conversions[1].Input)
+	require.Empty(t, conversions[1].Output)
+	require.NotEmpty(t, conversions[1].Error)
+}
+
+func TestAccountProcessor_ConvertHexAddressesToBech32(t *testing.T) {
+	t.Parallel()
+
+	ap := createAccountProcessorForAddressUtils(t)
+	conversions := ap.ConvertHexAddressesToBech32([]string{aliceHex, "not hex", "0139"})
+	require.Len(t, conversions, 3)
+	require.Equal(t, &data.AddressConversion{Input: aliceHex, Output: aliceBech32}, conversions[0])
+	require.NotEmpty(t, conversions[1].Error)
+	require.Contains(t, conversions[2].Error, process.ErrInvalidAddressLength.Error())
+}
+
+func TestAccountProcessor_ComputeShardIDsForAddresses(t *testing.T) {
+	t.Parallel()
+
+	ap := createAccountProcessorForAddressUtils(t)
+	shardIDs := ap.ComputeShardIDsForAddresses([]string{aliceBech32, "invalid"})
+	require.Len(t, shardIDs, 2)
+	require.Equal(t, aliceBech32, shardIDs[0].Address)
+	require.Equal(t, uint32(0xe1%3), *shardIDs[0].ShardID)
+	require.Empty(t, shardIDs[0].Error)
+	require.Nil(t, shardIDs[1].ShardID)
+	require.NotEmpty(t, shardIDs[1].Error)
+}
+
+func TestAccountProcessor_ValidateAddresses(t *testing.T) {
+	t.Parallel()
+
+	aliceBytes, _ := hex.DecodeString(aliceHex)
+	otherHRPConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "moa")
+	otherHRPAddress, _ := otherHRPConverter.Encode(aliceBytes)
+	shortAddressConverter, _ := pubkeyConverter.NewBech32PubkeyConverter(20, "erd")
+	shortAddress, _ := shortAddressConverter.Encode(aliceBytes[:20])
+
+	ap := createAccountProcessorForAddressUtils(t)
+	validations := ap.ValidateAddresses([]string{aliceBech32, otherHRPAddress, shortAddress, aliceHex})
+	require.Len(t, validations, 4)
+	require.Equal(t, &data.AddressValidation{Address: aliceBech32, IsValid: true}, validations[0])
+	for _, validation := range validations[1:] {
+		require.False(t, validation.IsValid)
+		require.NotEmpty(t, validation.Error)
+	}
+	require.Contains(t, validations[2].Error, pubkeyConverter.ErrWrongSize.Error())
+}
+
+func TestAccountProcessor_ComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid deployer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForAddressUtils(t)
+		contractAddress, err := ap.ComputeContractAddress("invalid", 0)
+		require.Nil(t, contractAddress)
+		require.NotNil(t, err)
+	})
+	t.Run("compute shard ID error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, expectedErr
+				},
+			},
+			testPubkeyConverter,
+		)
+
+		contractAddress, err := ap.ComputeContractAddress(aliceBech32, 0)
+		require.Nil(t, contractAddress)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should compute the address the same way as the protocol", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createAccountProcessorForAddressUtils(t)
+		contractAddress, err := ap.ComputeContractAddress(aliceBech32, 7)
+		require.Nil(t, err)
+
+		contractAddressBytes, _ := hex.DecodeString(contractAddress.Hex)
+		require.Len(t, contractAddressBytes, 32)
+		require.True(t, core.IsSmartContractAddress(contractAddressBytes))
+		require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 5, 0}, contractAddressBytes[:10])
+		require.Equal(t, []byte{0x69, 0xe1}, contractAddressBytes[30:])
+
+		aliceBytes, _ := hex.DecodeString(aliceHex)
+		nonceBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(nonceBytes, 7)
+		hash := keccak.NewKeccak().Compute(string(append(aliceBytes, nonceBytes...)))
+		require.Equal(t, hash[10:30], contractAddressBytes[10:30])
+
+		encodedAddress, _ := testPubkeyConverter.Encode(contractAddressBytes)
+		require.Equal(t, encodedAddress, contractAddress.Address)
+		require.Equal(t, uint32(0xe1%3), contractAddress.ShardID)
+
+		otherNonceAddress, err := ap.ComputeContractAddress(aliceBech32, 8)
+		require.Nil(t, err)
+		require.NotEqual(t, contractAddress.Address, otherNonceAddress.Address)
+	})
+}
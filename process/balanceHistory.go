@@ -0,0 +1,208 @@
+package process
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxConcurrentBalanceHistoryRequests defines how many balance history points are fetched at the same time
+const maxConcurrentBalanceHistoryRequests = 10
+
+// GetBalanceHistory returns the balance, the nonce and the requested ESDT balances of the account at each point of
+// the provided nonce or epoch range. The points are fetched concurrently from the observers holding the historical
+// state and, since the state of a final block never changes, the points at or below the final nonce are cached
+func (ap *AccountProcessor) GetBalanceHistory(address string, options common.BalanceHistoryOptions) (*data.AccountBalanceHistory, error) {
+	numPoints := options.NumPoints()
+	if numPoints == 0 || numPoints > common.MaxBalanceHistoryPoints {
+		return nil, ErrInvalidBalanceHistoryRange
+	}
+
+	pointsOptions := createBalanceHistoryQueryOptions(options, numPoints)
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(pointsOptions[0])
+	observers, err := ap.getObserversForAddress(address, availability, core.OptionalUint32{})
+	if err != nil {
+		return nil, err
+	}
+	if len(observers) == 0 {
+		return nil, ErrMissingObserver
+	}
+
+	finalNonce := ap.getHighestFinalNonce(observers[0].ShardId)
+
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var requestErr error
+	points := make([]*data.BalanceHistoryPoint, len(pointsOptions))
+	throttler := make(chan struct{}, maxConcurrentBalanceHistoryRequests)
+	for idx, pointOptions := range pointsOptions {
+		throttler <- struct{}{}
+		wg.Add(1)
+		go func(idx int, pointOptions common.AccountQueryOptions) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			point, errGet := ap.getBalanceHistoryPoint(observers, address, options.Tokens, pointOptions, finalNonce)
+
+			mut.Lock()
+			defer mut.Unlock()
+
+			if errGet != nil {
+				requestErr = errGet
+				return
+			}
+
+			points[idx] = point
+		}(idx, pointOptions)
+	}
+
+	wg.Wait()
+
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	log.Info("balance history request", "address", address, "num points", numPoints)
+
+	return &data.AccountBalanceHistory{
+		Address: address,
+		Points:  points,
+	}, nil
+}
+
+// createBalanceHistoryQueryOptions returns the account query options of every point of the balance history
+func createBalanceHistoryQueryOptions(options common.BalanceHistoryOptions, numPoints uint64) []common.AccountQueryOptions {
+	pointsOptions := make([]common.AccountQueryOptions, 0, numPoints)
+	for i := uint64(0); i < numPoints; i++ {
+		offset := i * options.Step
+		if options.IsEpochRange() {
+			pointsOptions = append(pointsOptions, common.AccountQueryOptions{
+				OnStartOfEpoch: core.OptionalUint32{Value: options.FromEpoch.Value + uint32(offset), HasValue: true},
+			})
+			continue
+		}
+
+		pointsOptions = append(pointsOptions, common.AccountQueryOptions{
+			BlockNonce: core.OptionalUint64{Value: options.FromNonce.Value + offset, HasValue: true},
+		})
+	}
+
+	return pointsOptions
+}
+
+// getHighestFinalNonce returns the highest final nonce of the shard, if it can be fetched. Without it, no point is
+// cached, as a point above the final nonce could still be reverted
+func (ap *AccountProcessor) getHighestFinalNonce(shardID uint32) core.OptionalUint64 {
+	finalNonce, err := fetchNodeStatusUintMetric(ap.proc, shardID, MetricHighestFinalNonce)
+	if err != nil {
+		log.Debug("balance history final nonce", "shard ID", shardID, "error", err.Error())
+		return core.OptionalUint64{}
+	}
+
+	return core.OptionalUint64{Value: finalNonce, HasValue: true}
+}
+
+func (ap *AccountProcessor) getBalanceHistoryPoint(
+	observers []*data.NodeData,
+	address string,
+	tokens []string,
+	options common.AccountQueryOptions,
+	finalNonce core.OptionalUint64,
+) (*data.BalanceHistoryPoint, error) {
+	account, err := ap.getHistoricalAccount(observers, address, options, finalNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	isFinal := finalNonce.HasValue && account.BlockInfo.Nonce <= finalNonce.Value
+	point := &data.BalanceHistoryPoint{
+		BlockNonce: account.BlockInfo.Nonce,
+		Balance:    account.Account.Balance,
+		Nonce:      account.Account.Nonce,
+		BlockInfo:  account.BlockInfo,
+	}
+	if options.OnStartOfEpoch.HasValue {
+		epoch := options.OnStartOfEpoch.Value
+		point.Epoch = &epoch
+	}
+	if len(tokens) == 0 {
+		return point, nil
+	}
+
+	point.ESDTBalances = make(map[string]string, len(tokens))
+	for _, token := range tokens {
+		point.ESDTBalances[token], err = ap.getHistoricalESDTBalance(observers, address, token, options, isFinal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return point, nil
+}
+
+func (ap *AccountProcessor) getHistoricalAccount(
+	observers []*data.NodeData,
+	address string,
+	options common.AccountQueryOptions,
+	finalNonce core.OptionalUint64,
+) (*data.AccountModel, error) {
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	cachedAccount, found := ap.historicalStateCache.Get(apiPath)
+	if found {
+		return cachedAccount.(*data.AccountModel), nil
+	}
+
+	responseAccount := data.AccountApiResponse{}
+	for _, observer := range observers {
+		_, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &responseAccount)
+		if err == nil {
+			if finalNonce.HasValue && responseAccount.Data.BlockInfo.Nonce <= finalNonce.Value {
+				ap.historicalStateCache.Put(apiPath, &responseAccount.Data)
+			}
+			return &responseAccount.Data, nil
+		}
+
+		log.Error("balance history account request", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, fmt.Errorf("%w for %s", WrapObserversError(responseAccount.Error), apiPath)
+}
+
+func (ap *AccountProcessor) getHistoricalESDTBalance(
+	observers []*data.NodeData,
+	address string,
+	token string,
+	options common.AccountQueryOptions,
+	isFinal bool,
+) (string, error) {
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/esdt/"+token, options)
+	cachedBalance, found := ap.historicalStateCache.Get(apiPath)
+	if found {
+		return cachedBalance.(string), nil
+	}
+
+	apiResponse := data.AccountESDTTokenDataApiResponse{}
+	for _, observer := range observers {
+		_, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		if err == nil {
+			balance := apiResponse.Data.TokenData.Balance
+			if len(balance) == 0 {
+				balance = zeroBalance
+			}
+
+			if isFinal {
+				ap.historicalStateCache.Put(apiPath, balance)
+			}
+			return balance, nil
+		}
+
+		log.Error("balance history ESDT request", "observer", observer.Address, "address", address, "token", token, "error", err.Error())
+	}
+
+	return "", fmt.Errorf("%w for %s", WrapObserversError(apiResponse.Error), apiPath)
+}
@@ -0,0 +1,259 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountProcessor_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+
+		history, err := ap.GetBalanceHistory("DEADBEEF", common.BalanceHistoryOptions{})
+		require.Nil(t, history)
+		require.Equal(t, process.ErrInvalidBalanceHistoryRange, err)
+
+		history, err = ap.GetBalanceHistory("DEADBEEF", common.BalanceHistoryOptions{
+			FromNonce: core.OptionalUint64{Value: 0, HasValue: true},
+			ToNonce:   core.OptionalUint64{Value: common.MaxBalanceHistoryPoints, HasValue: true},
+			Step:      1,
+		})
+		require.Nil(t, history)
+		require.Equal(t, process.ErrInvalidBalanceHistoryRange, err)
+	})
+	t.Run("failing request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if strings.Contains(path, "blockNonce=12") {
+						return http.StatusNotFound, errors.New("state not found")
+					}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		history, err := ap.GetBalanceHistory("DEADBEEF", common.BalanceHistoryOptions{
+			FromNonce: core.OptionalUint64{Value: 10, HasValue: true},
+			ToNonce:   core.OptionalUint64{Value: 14, HasValue: true},
+			Step:      1,
+		})
+		require.Nil(t, history)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("should sample the nonce range from full history observers and cache the points", func(t *testing.T) {
+		t.Parallel()
+
+		mutCalls := sync.Mutex{}
+		calledPaths := make(map[string]int)
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					if dataAvailability == data.AvailabilityRecent {
+						return []*data.NodeData{{Address: "recent", ShardId: shardId}}, nil
+					}
+
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if path == process.NodeStatusPath {
+						require.Equal(t, "recent", address)
+						setBalanceHistoryFinalNonce(value, 20)
+						return http.StatusOK, nil
+					}
+
+					require.Equal(t, "observer", address)
+					mutCalls.Lock()
+					calledPaths[path]++
+					mutCalls.Unlock()
+
+					switch response := value.(type) {
+					case *data.AccountApiResponse:
+						nonce := uint64(10)
+						if strings.Contains(path, "blockNonce=20") {
+							nonce = 20
+						}
+						response.Data.Account = data.Account{Balance: "1000", Nonce: nonce / 10}
+						response.Data.BlockInfo = data.BlockInfo{Nonce: nonce}
+					case *data.AccountESDTTokenDataApiResponse:
+						if strings.Contains(path, "/esdt/MEX-abcdef") {
+							response.Data.TokenData = data.AccountESDTToken{TokenIdentifier: "MEX-abcdef", Balance: "50"}
+						}
+					}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		options := common.BalanceHistoryOptions{
+			FromNonce: core.OptionalUint64{Value: 10, HasValue: true},
+			ToNonce:   core.OptionalUint64{Value: 25, HasValue: true},
+			Step:      10,
+			Tokens:    []string{"MEX-abcdef", "WEGLD-abcdef"},
+		}
+		history, err := ap.GetBalanceHistory("DEADBEEF", options)
+		require.Nil(t, err)
+		require.Equal(t, &data.AccountBalanceHistory{
+			Address: "DEADBEEF",
+			Points: []*data.BalanceHistoryPoint{
+				{
+					BlockNonce:   10,
+					Balance:      "1000",
+					Nonce:        1,
+					ESDTBalances: map[string]string{"MEX-abcdef": "50", "WEGLD-abcdef": "0"},
+					BlockInfo:    data.BlockInfo{Nonce: 10},
+				},
+				{
+					BlockNonce:   20,
+					Balance:      "1000",
+					Nonce:        2,
+					ESDTBalances: map[string]string{"MEX-abcdef": "50", "WEGLD-abcdef": "0"},
+					BlockInfo:    data.BlockInfo{Nonce: 20},
+				},
+			},
+		}, history)
+		require.Len(t, calledPaths, 6)
+
+		_, err = ap.GetBalanceHistory("DEADBEEF", options)
+		require.Nil(t, err)
+		for path, numCalls := range calledPaths {
+			require.Equal(t, 1, numCalls, path)
+		}
+	})
+	t.Run("should sample the epoch range", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if path == process.NodeStatusPath {
+						setBalanceHistoryFinalNonce(value, 1000)
+						return http.StatusOK, nil
+					}
+
+					assert.True(t, strings.Contains(path, "onStartOfEpoch="))
+					response := value.(*data.AccountApiResponse)
+					response.Data.Account = data.Account{Balance: "5"}
+					response.Data.BlockInfo = data.BlockInfo{Nonce: 1000}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		history, err := ap.GetBalanceHistory("DEADBEEF", common.BalanceHistoryOptions{
+			FromEpoch: core.OptionalUint32{Value: 0, HasValue: true},
+			ToEpoch:   core.OptionalUint32{Value: 2, HasValue: true},
+			Step:      1,
+		})
+		require.Nil(t, err)
+		require.Len(t, history.Points, 3)
+		for idx, point := range history.Points {
+			require.Equal(t, uint32(idx), *point.Epoch)
+			require.Equal(t, "5", point.Balance)
+			require.Nil(t, point.ESDTBalances)
+		}
+	})
+	t.Run("points above the final nonce should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		mutCalls := sync.Mutex{}
+		calledPaths := make(map[string]int)
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					if path == process.NodeStatusPath {
+						setBalanceHistoryFinalNonce(value, 15)
+						return http.StatusOK, nil
+					}
+
+					mutCalls.Lock()
+					calledPaths[path]++
+					mutCalls.Unlock()
+
+					response := value.(*data.AccountApiResponse)
+					nonce := uint64(10)
+					if strings.Contains(path, "blockNonce=20") {
+						nonce = 20
+					}
+					response.Data.Account = data.Account{Balance: "1000"}
+					response.Data.BlockInfo = data.BlockInfo{Nonce: nonce}
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		options := common.BalanceHistoryOptions{
+			FromNonce: core.OptionalUint64{Value: 10, HasValue: true},
+			ToNonce:   core.OptionalUint64{Value: 20, HasValue: true},
+			Step:      10,
+		}
+		for i := 0; i < 2; i++ {
+			_, err := ap.GetBalanceHistory("DEADBEEF", options)
+			require.Nil(t, err)
+		}
+
+		require.Len(t, calledPaths, 2)
+		for path, numCalls := range calledPaths {
+			if strings.Contains(path, "blockNonce=20") {
+				require.Equal(t, 2, numCalls, path)
+				continue
+			}
+			require.Equal(t, 1, numCalls, path)
+		}
+	})
+}
+
+func setBalanceHistoryFinalNonce(value interface{}, finalNonce uint64) {
+	response := value.(*data.GenericAPIResponse)
+	response.Data = map[string]interface{}{
+		"metrics": map[string]interface{}{
+			process.MetricHighestFinalNonce: float64(finalNonce),
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package process
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// networkTiming holds the network settings needed to relate the timestamps to the rounds
+type networkTiming struct {
+	startTime       int64
+	roundDurationMs int64
+}
+
+// GetBlockByTimestamp returns the latest block of the given shard produced at or before the provided Unix timestamp
+func (bp *BlockProcessor) GetBlockByTimestamp(shardID uint32, timestamp uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	nonce, err := bp.getNonceByTimestamp(shardID, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.GetBlockByNonce(shardID, nonce, options)
+}
+
+// GetHyperBlockByTimestamp returns the latest hyperblock produced at or before the provided Unix timestamp
+func (bp *BlockProcessor) GetHyperBlockByTimestamp(timestamp uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	nonce, err := bp.getNonceByTimestamp(core.MetachainShardId, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.GetHyperBlockByNonce(nonce, options)
+}
+
+// getNonceByTimestamp binary searches the nonce of the latest block produced at or before the provided timestamp.
+// As no more blocks than rounds can be produced in a time interval, the search starts from the latest block and
+// only goes back as many nonces as rounds passed since the provided timestamp
+func (bp *BlockProcessor) getNonceByTimestamp(shardID uint32, timestamp uint64) (uint64, error) {
+	cacheKey := fmt.Sprintf("timestamp_%d_%d", shardID, timestamp)
+	nonce := uint64(0)
+	if bp.blocksCache.Get(cacheKey, &nonce) {
+		return nonce, nil
+	}
+
+	timing, err := bp.getNetworkTiming()
+	if err != nil {
+		return 0, err
+	}
+	if int64(timestamp) < timing.startTime {
+		return 0, fmt.Errorf("%w: genesis time is %d", ErrTimestampBeforeGenesis, timing.startTime)
+	}
+
+	latestNonce, err := fetchNodeStatusUintMetric(bp.proc, shardID, MetricNonce)
+	if err != nil {
+		return 0, err
+	}
+	latestTimestamp, err := bp.getBlockTimestamp(shardID, latestNonce)
+	if err != nil {
+		return 0, err
+	}
+	if latestTimestamp <= int64(timestamp) {
+		return latestNonce, nil
+	}
+
+	low := uint64(0)
+	if timing.roundDurationMs > 0 {
+		numRounds := uint64((latestTimestamp-int64(timestamp))*1000/timing.roundDurationMs) + 1
+		if latestNonce > numRounds {
+			low = latestNonce - numRounds
+		}
+	}
+
+	lowTimestamp, err := bp.getBlockTimestamp(shardID, low)
+	if err != nil {
+		return 0, err
+	}
+	if lowTimestamp > int64(timestamp) && low > 0 {
+		low = 0
+		lowTimestamp, err = bp.getBlockTimestamp(shardID, low)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if lowTimestamp > int64(timestamp) {
+		return 0, ErrNoBlockBeforeTimestamp
+	}
+
+	// the block at low was produced at or before the timestamp, while the one at high after it
+	high := latestNonce
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		middleTimestamp, errGet := bp.getBlockTimestamp(shardID, middle)
+		if errGet != nil {
+			return 0, errGet
+		}
+
+		if middleTimestamp <= int64(timestamp) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	// the result can no longer change once the block following it is final
+	bp.blocksCache.PutIfFinal(shardID, high, cacheKey, low)
+
+	return low, nil
+}
+
+func (bp *BlockProcessor) getBlockTimestamp(shardID uint32, nonce uint64) (int64, error) {
+	response, err := bp.GetBlockByNonce(shardID, nonce, common.BlockQueryOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("%w for nonce %d", err, nonce)
+	}
+
+	return response.Data.Block.Timestamp, nil
+}
+
+func (bp *BlockProcessor) getNetworkTiming() (*networkTiming, error) {
+	bp.mutNetworkTiming.Lock()
+	defer bp.mutNetworkTiming.Unlock()
+
+	if bp.networkTiming != nil {
+		return bp.networkTiming, nil
+	}
+
+	observers, err := bp.proc.GetAllObservers(data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	response := data.NetworkConfigApiResponse{}
+	for _, observer := range observers {
+		_, err = bp.proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &response)
+		if err != nil {
+			log.Error("network config request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		bp.networkTiming = &networkTiming{
+			startTime:       response.Data.Config.StartTime,
+			roundDurationMs: response.Data.Config.RoundDuration,
+		}
+		return bp.networkTiming, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
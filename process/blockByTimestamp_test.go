@@ -0,0 +1,147 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	genesisTime     = int64(1000)
+	roundDurationMs = int64(6000)
+	latestNonce     = uint64(100)
+)
+
+// every 10th round is skipped, so the blocks are produced less often than the round duration suggests
+func timestampOfNonce(nonce uint64) int64 {
+	return genesisTime + int64(nonce+nonce/10)*roundDurationMs/1000
+}
+
+func newTimestampedBlocksObservers(t *testing.T, numBlockRequests *int) *observersStub {
+	observers := newObserversStub(t, 0)
+	observers.setMetric(process.MetricNonce, latestNonce)
+	observers.handle(process.NetworkConfigPath, func(_ uint32, _ string, value interface{}) (int, error) {
+		response := value.(*data.NetworkConfigApiResponse)
+		response.Data.Config.StartTime = genesisTime
+		response.Data.Config.RoundDuration = roundDurationMs
+		return 200, nil
+	})
+	observers.handle("/block/by-nonce/", func(_ uint32, path string, value interface{}) (int, error) {
+		*numBlockRequests++
+		nonce := uint64(0)
+		_, err := fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+		assert.Nil(t, err)
+
+		response := value.(*data.BlockApiResponse)
+		response.Data.Block = api.Block{Nonce: nonce, Timestamp: timestampOfNonce(nonce)}
+		return 200, nil
+	})
+
+	return observers
+}
+
+func TestBlockProcessor_GetBlockByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("timestamp before genesis should error", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := 0
+		bp, _ := process.NewBlockProcessor(newTimestampedBlocksObservers(t, &numBlockRequests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetBlockByTimestamp(0, uint64(genesisTime-1), common.BlockQueryOptions{})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrTimestampBeforeGenesis))
+		require.Zero(t, numBlockRequests)
+	})
+	t.Run("timestamp after the latest block should return the latest block", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := 0
+		bp, _ := process.NewBlockProcessor(newTimestampedBlocksObservers(t, &numBlockRequests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetBlockByTimestamp(0, uint64(timestampOfNonce(latestNonce)+100), common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, latestNonce, response.Data.Block.Nonce)
+	})
+	t.Run("should find the latest block at or before the timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		for nonce := uint64(0); nonce < latestNonce; nonce++ {
+			numBlockRequests := 0
+			bp, _ := process.NewBlockProcessor(newTimestampedBlocksObservers(t, &numBlockRequests).processor(), &mock.BlocksCacheStub{})
+
+			response, err := bp.GetBlockByTimestamp(0, uint64(timestampOfNonce(nonce)), common.BlockQueryOptions{})
+			require.Nil(t, err)
+			require.Equal(t, nonce, response.Data.Block.Nonce)
+
+			// a timestamp between two blocks should resolve to the earlier one
+			response, err = bp.GetBlockByTimestamp(0, uint64(timestampOfNonce(nonce+1)-1), common.BlockQueryOptions{})
+			require.Nil(t, err)
+			require.Equal(t, nonce, response.Data.Block.Nonce)
+		}
+	})
+	t.Run("search should be seeded by the round duration", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := 0
+		bp, _ := process.NewBlockProcessor(newTimestampedBlocksObservers(t, &numBlockRequests).processor(), &mock.BlocksCacheStub{})
+
+		_, err := bp.GetBlockByTimestamp(0, uint64(timestampOfNonce(latestNonce-3)), common.BlockQueryOptions{})
+		require.Nil(t, err)
+		// latest block, lower bound, 2 bisection steps and the returned block
+		require.Equal(t, 5, numBlockRequests)
+	})
+	t.Run("cached nonce should not be searched again", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := 0
+		cachedNonces := make(map[string]uint64)
+		blocksCache := &mock.BlocksCacheStub{
+			GetCalled: func(key string, value interface{}) bool {
+				nonce, found := cachedNonces[key]
+				if found {
+					*value.(*uint64) = nonce
+				}
+				return found
+			},
+			PutIfFinalCalled: func(shardID uint32, nonce uint64, key string, value interface{}) {
+				if strings.HasPrefix(key, "timestamp_") {
+					cachedNonces[key] = value.(uint64)
+				}
+			},
+		}
+		bp, _ := process.NewBlockProcessor(newTimestampedBlocksObservers(t, &numBlockRequests).processor(), blocksCache)
+
+		response, err := bp.GetBlockByTimestamp(0, uint64(timestampOfNonce(42)), common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint64(42), response.Data.Block.Nonce)
+
+		numBlockRequests = 0
+		response, err = bp.GetBlockByTimestamp(0, uint64(timestampOfNonce(42)), common.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint64(42), response.Data.Block.Nonce)
+		require.Equal(t, 1, numBlockRequests)
+	})
+}
+
+func TestBlockProcessor_GetHyperBlockByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	numBlockRequests := 0
+	proc := newTimestampedBlocksObservers(t, &numBlockRequests).processor()
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
+
+	response, err := bp.GetHyperBlockByTimestamp(uint64(timestampOfNonce(37)+1), common.HyperblockQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(37), response.Data.Hyperblock.Nonce)
+}
@@ -2,6 +2,7 @@ package process
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
 )
 
 const (
@@ -28,6 +30,8 @@ const (
 
 	alteredAccountByBlockNonce = "/block/altered-accounts/by-nonce"
 	alteredAccountByBlockHash  = "/block/altered-accounts/by-hash"
+
+	epochStartDataPath = "/node/epoch-start/%d"
 )
 
 const (
@@ -37,20 +41,58 @@ const (
 
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc Processor
+	proc                   Processor
+	blocksCache            BlocksCacheHandler
+	internalBlocksVerifier InternalBlocksVerifier
+
+	mutNetworkTiming sync.Mutex
+	networkTiming    *networkTiming
+
+	mutPastEpochs sync.RWMutex
+	pastEpochs    map[uint32]*data.EpochBoundaries
+
+	miniBlockMissesCache ImmutableDataCacheHandler
 }
 
-// NewBlockProcessor will create a new block processor
-func NewBlockProcessor(proc Processor) (*BlockProcessor, error) {
+// NewBlockProcessor will create a new block processor. The blocks and hyperblocks below the final nonce are kept in
+// the provided cache, as they never change
+func NewBlockProcessor(proc Processor, blocksCache BlocksCacheHandler) (*BlockProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(blocksCache) {
+		return nil, ErrNilBlocksCache
+	}
+
+	miniBlockMissesCache, err := cache.NewTTLCache(miniBlockMissesCacheCapacity, miniBlockMissesCacheValidity)
+	if err != nil {
+		return nil, err
+	}
 
 	return &BlockProcessor{
-		proc: proc,
+		proc:                 proc,
+		blocksCache:          blocksCache,
+		pastEpochs:           make(map[uint32]*data.EpochBoundaries),
+		miniBlockMissesCache: miniBlockMissesCache,
 	}, nil
 }
 
+// SetInternalBlocksVerifier enables the verification of the raw internal blocks returned by the observers, against the
+// requested hash or nonce. It should be called before the processor is used
+func (bp *BlockProcessor) SetInternalBlocksVerifier(verifier InternalBlocksVerifier) error {
+	if check.IfNil(verifier) {
+		return ErrNilInternalBlocksVerifier
+	}
+
+	bp.internalBlocksVerifier = verifier
+
+	return nil
+}
+
+func (bp *BlockProcessor) shouldVerifyInternalBlock(format common.OutputFormat) bool {
+	return format == common.Proto && !check.IfNil(bp.internalBlocksVerifier)
+}
+
 // GetBlockByHash will return the block based on its hash
 func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
@@ -59,8 +101,13 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	}
 
 	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%s", blockByHashPath, hash), options)
+	cacheKey := getShardCacheKey(shardID, path)
 
 	response := data.BlockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, &response) {
+		return &response, nil
+	}
+
 	for _, observer := range observers {
 
 		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
@@ -70,6 +117,9 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 		}
 
 		log.Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		if !blockHasPendingTransactions(&response.Data.Block) {
+			bp.blocksCache.PutIfFinal(shardID, response.Data.Block.Nonce, cacheKey, &response)
+		}
 		return &response, nil
 
 	}
@@ -85,8 +135,13 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	}
 
 	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%d", blockByNoncePath, nonce), options)
+	cacheKey := getShardCacheKey(shardID, path)
 
 	response := data.BlockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, &response) {
+		return &response, nil
+	}
+
 	for _, observer := range observers {
 
 		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
@@ -96,6 +151,9 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 		}
 
 		log.Info("block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
+		if !blockHasPendingTransactions(&response.Data.Block) {
+			bp.blocksCache.PutIfFinal(shardID, nonce, cacheKey, &response)
+		}
 		return &response, nil
 
 	}
@@ -114,6 +172,26 @@ func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*dat
 
 // GetHyperBlockByHash returns the hyperblock by hash
 func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	filters := options.Filters
+	options.Filters = common.HyperblockFilters{}
+	strict := !options.AllowPartial
+	options.AllowPartial = false
+
+	response, err := bp.getHyperBlockByHash(hash, options, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterHyperblockResponse(response, filters), nil
+}
+
+func (bp *BlockProcessor) getHyperBlockByHash(hash string, options common.HyperblockQueryOptions, strict bool) (*data.HyperblockApiResponse, error) {
+	cacheKey := getHyperblockCacheKey(fmt.Sprintf("by-hash/%s", hash), options)
+	cachedResponse := &data.HyperblockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -130,39 +208,88 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	missingShardBlocks, err := bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions, strict)
 	if err != nil {
 		return nil, err
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	response := data.NewHyperblockApiResponse(hyperblock)
+	if len(missingShardBlocks) > 0 {
+		response.Data.Complete = false
+		response.Data.MissingShardBlocks = missingShardBlocks
+		return response, nil
+	}
+	if hasPendingTransactions(hyperblock.Transactions) {
+		return response, nil
+	}
+
+	bp.blocksCache.PutIfFinal(core.MetachainShardId, hyperblock.Nonce, cacheKey, response)
+
+	return response, nil
 }
 
+// addShardBlocks fetches the shard blocks notarized in the metablock and checks that each fetched block has the
+// notarized hash. The blocks which cannot be fetched or do not match are returned as missing, unless strict is set, in
+// which case the first one fails the whole hyperblock with data.ErrIncompleteHyperblock
 func (bp *BlockProcessor) addShardBlocks(
 	metaBlock api.Block,
 	builder *hyperblockBuilder,
 	options common.HyperblockQueryOptions,
 	blockQueryOptions common.BlockQueryOptions,
-) error {
+	strict bool,
+) ([]*data.MissingShardBlock, error) {
+	missingShardBlocks := make([]*data.MissingShardBlock, 0)
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlock, alteredAccounts, err := bp.getNotarizedShardBlock(notarizedBlock, options, blockQueryOptions)
 		if err != nil {
-			return err
-		}
-
-		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
-		if err != nil {
-			return err
+			if strict {
+				return nil, fmt.Errorf("%w: shard %d, nonce %d, hash %s: %s",
+					data.ErrIncompleteHyperblock, notarizedBlock.Shard, notarizedBlock.Nonce, notarizedBlock.Hash, err.Error())
+			}
+
+			log.Warn("incomplete hyperblock", "nonce", metaBlock.Nonce, "shard", notarizedBlock.Shard,
+				"shard block hash", notarizedBlock.Hash, "error", err)
+			missingShardBlocks = append(missingShardBlocks, &data.MissingShardBlock{
+				Shard:  notarizedBlock.Shard,
+				Nonce:  notarizedBlock.Nonce,
+				Hash:   notarizedBlock.Hash,
+				Reason: err.Error(),
+			})
+			continue
 		}
 
 		builder.addShardBlock(&shardBlockWithAlteredAccounts{
-			shardBlock:      &shardBlockResponse.Data.Block,
+			shardBlock:      shardBlock,
 			alteredAccounts: alteredAccounts,
 		})
 	}
 
-	return nil
+	return missingShardBlocks, nil
+}
+
+func (bp *BlockProcessor) getNotarizedShardBlock(
+	notarizedBlock *api.NotarizedBlock,
+	options common.HyperblockQueryOptions,
+	blockQueryOptions common.BlockQueryOptions,
+) (*api.Block, []*alteredAccount.AlteredAccount, error) {
+	shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shardBlock := &shardBlockResponse.Data.Block
+	if shardBlock.Hash != notarizedBlock.Hash || shardBlock.Shard != notarizedBlock.Shard {
+		return nil, nil, fmt.Errorf("fetched block of shard %d with hash %s does not match the notarized one",
+			shardBlock.Shard, shardBlock.Hash)
+	}
+
+	alteredAccounts, err := bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return shardBlock, alteredAccounts, nil
 }
 
 func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
@@ -181,6 +308,28 @@ func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQu
 
 // GetHyperBlockByNonce returns the hyperblock by nonce
 func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	filters := options.Filters
+	options.Filters = common.HyperblockFilters{}
+	strict := !options.AllowPartial
+	options.AllowPartial = false
+
+	response, err := bp.getHyperBlockByNonce(nonce, options, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterHyperblockResponse(response, filters), nil
+}
+
+// getHyperBlockByNonce builds the unfiltered hyperblock, which is cached regardless of the filters of the request. Only
+// complete hyperblocks without pending transactions are cached
+func (bp *BlockProcessor) getHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions, strict bool) (*data.HyperblockApiResponse, error) {
+	cacheKey := getHyperblockCacheKey(fmt.Sprintf("by-nonce/%d", nonce), options)
+	cachedResponse := &data.HyperblockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -197,13 +346,25 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	missingShardBlocks, err := bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions, strict)
 	if err != nil {
 		return nil, err
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	response := data.NewHyperblockApiResponse(hyperblock)
+	if len(missingShardBlocks) > 0 {
+		response.Data.Complete = false
+		response.Data.MissingShardBlocks = missingShardBlocks
+		return response, nil
+	}
+	if hasPendingTransactions(hyperblock.Transactions) {
+		return response, nil
+	}
+
+	bp.blocksCache.PutIfFinal(core.MetachainShardId, nonce, cacheKey, response)
+
+	return response, nil
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
@@ -217,8 +378,14 @@ func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, fo
 	if err != nil {
 		return nil, err
 	}
+	cacheKey := getShardCacheKey(shardID, path)
 
 	response := data.InternalBlockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, &response) {
+		return &response, nil
+	}
+
+	var verificationErr error
 	for _, observer := range observers {
 
 		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
@@ -227,11 +394,25 @@ func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, fo
 			continue
 		}
 
+		if bp.shouldVerifyInternalBlock(format) {
+			verificationErr = bp.internalBlocksVerifier.VerifyInternalBlockByHash(shardID, hash, response.Data.Block)
+			if verificationErr != nil {
+				log.Warn("internal block request", "observer", observer.Address, "error", verificationErr.Error())
+				continue
+			}
+		}
+
 		log.Info("internal block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		// the internal block is the header identified by the hash, so it never changes
+		bp.blocksCache.Put(cacheKey, &response)
 		return &response, nil
 
 	}
 
+	if verificationErr != nil {
+		return nil, verificationErr
+	}
+
 	return nil, WrapObserversError(response.Error)
 }
 
@@ -263,8 +444,14 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 	if err != nil {
 		return nil, err
 	}
+	cacheKey := getShardCacheKey(shardID, path)
 
 	response := data.InternalBlockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, &response) {
+		return &response, nil
+	}
+
+	var verificationErr error
 	for _, observer := range observers {
 
 		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
@@ -273,14 +460,94 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 			continue
 		}
 
+		if bp.shouldVerifyInternalBlock(format) {
+			verificationErr = bp.verifyInternalBlockByNonce(observer, observers, shardID, nonce, format, response.Data.Block)
+			if verificationErr != nil {
+				log.Warn("internal block request", "observer", observer.Address, "error", verificationErr.Error())
+				continue
+			}
+		}
+
 		log.Info("internal block request", "shard id", observer.ShardId, "round", nonce, "observer", observer.Address)
+		bp.blocksCache.PutIfFinal(shardID, nonce, cacheKey, &response)
 		return &response, nil
 
 	}
 
+	if verificationErr != nil {
+		return nil, verificationErr
+	}
+
 	return nil, WrapObserversError(response.Error)
 }
 
+// verifyInternalBlockByNonce checks the raw block against the requested nonce and against the previous block, so an
+// observer cannot vouch for its own block, the previous block is never requested from the observer returning the block
+func (bp *BlockProcessor) verifyInternalBlockByNonce(
+	observer *data.NodeData,
+	observers []*data.NodeData,
+	shardID uint32,
+	nonce uint64,
+	format common.OutputFormat,
+	rawBlock interface{},
+) error {
+	var previousRawBlock interface{}
+	if nonce > 0 {
+		var err error
+		previousRawBlock, err = bp.getPreviousInternalBlock(observer, observers, shardID, nonce-1, format)
+		if err != nil {
+			return err
+		}
+	}
+
+	return bp.internalBlocksVerifier.VerifyInternalBlockByNonce(shardID, nonce, rawBlock, previousRawBlock)
+}
+
+// getPreviousInternalBlock returns the previous raw block from the cache, which only holds verified blocks, or else
+// requests it from the other observers of the shard. As a last resort, the previous block is requested from the observer
+// that served the verified block: its hash is recomputed locally, so the linkage check still catches corrupted or
+// mismatched responses
+func (bp *BlockProcessor) getPreviousInternalBlock(
+	verifiedObserver *data.NodeData,
+	observers []*data.NodeData,
+	shardID uint32,
+	previousNonce uint64,
+	format common.OutputFormat,
+) (interface{}, error) {
+	previousPath, err := getInternalBlockByNoncePath(shardID, format, previousNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	previousResponse := data.InternalBlockApiResponse{}
+	if bp.blocksCache.Get(getShardCacheKey(shardID, previousPath), &previousResponse) {
+		return previousResponse.Data.Block, nil
+	}
+
+	for _, observer := range observers {
+		if observer.Address == verifiedObserver.Address {
+			continue
+		}
+
+		_, err = bp.proc.CallGetRestEndPoint(observer.Address, previousPath, &previousResponse)
+		if err != nil {
+			log.Debug("previous internal block request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return previousResponse.Data.Block, nil
+	}
+
+	log.Warn("previous internal block requested from the observer that served the verified block",
+		"shard", shardID, "nonce", previousNonce, "observer", verifiedObserver.Address)
+	_, err = bp.proc.CallGetRestEndPoint(verifiedObserver.Address, previousPath, &previousResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot get the previous block: %s", ErrInternalBlockVerification, err.Error())
+	}
+
+	return previousResponse.Data.Block, nil
+}
+
 func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, nonce uint64) (string, error) {
 	var path string
 
@@ -300,6 +567,18 @@ func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, non
 
 // GetInternalMiniBlockByHash will return the miniblock based on its hash
 func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+	return bp.getInternalMiniBlockByHash(shardID, hash, epoch, format, log.Error)
+}
+
+// getInternalMiniBlockByHash requests the miniblock from the observers, logging their failures with the provided
+// function
+func (bp *BlockProcessor) getInternalMiniBlockByHash(
+	shardID uint32,
+	hash string,
+	epoch uint32,
+	format common.OutputFormat,
+	logRequestFailure func(message string, args ...interface{}),
+) (*data.InternalMiniBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -310,17 +589,24 @@ func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string
 		return nil, err
 	}
 	path := fmt.Sprintf(internalMiniBlockByHashPath, outputStr, hash, epoch)
+	cacheKey := getShardCacheKey(shardID, path)
 
 	response := data.InternalMiniBlockApiResponse{}
+	if bp.blocksCache.Get(cacheKey, &response) {
+		return &response, nil
+	}
+
 	for _, observer := range observers {
 
 		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
 		if err != nil {
-			log.Error("miniblock request", "observer", observer.Address, "error", err.Error())
+			logRequestFailure("miniblock request", "observer", observer.Address, "epoch", epoch, "error", err.Error())
 			continue
 		}
 
 		log.Info("miniblock request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+		// the miniblock is identified by its hash, so it never changes
+		bp.blocksCache.Put(cacheKey, &response)
 		return &response, nil
 
 	}
@@ -449,3 +735,8 @@ func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string,
 
 	return nil, WrapObserversError(response.Error)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *BlockProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
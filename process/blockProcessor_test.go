@@ -1,14 +1,18 @@
 package process_test
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -21,15 +25,23 @@ import (
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil)
+	bp, err := process.NewBlockProcessor(nil, &mock.BlocksCacheStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_NilBlocksCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, nil)
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilBlocksCache, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -51,7 +63,7 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -77,7 +89,7 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -99,7 +111,7 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -120,7 +132,7 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -143,7 +155,7 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -171,7 +183,7 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
@@ -200,7 +212,7 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
@@ -226,7 +238,7 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
@@ -248,7 +260,7 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
@@ -269,7 +281,7 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
@@ -292,7 +304,7 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
@@ -320,7 +332,7 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
@@ -346,20 +358,27 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 			response := value.(*data.BlockApiResponse)
 			response.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: 42}}
 
-			if strings.Contains(address, "4294967295") {
+			switch address {
+			case "observer-4294967295":
 				response.Data.Block.Hash = "abcd"
 				response.Data.Block.NotarizedBlocks = []*api.NotarizedBlock{
 					{Shard: 0, Nonce: 39, Hash: "zero"},
 					{Shard: 1, Nonce: 40, Hash: "one"},
 					{Shard: 2, Nonce: 41, Hash: "two"},
 				}
+			case "observer-0":
+				response.Data.Block = api.Block{Nonce: 39, Hash: "zero", Shard: 0}
+			case "observer-1":
+				response.Data.Block = api.Block{Nonce: 40, Hash: "one", Shard: 1}
+			case "observer-2":
+				response.Data.Block = api.Block{Nonce: 41, Hash: "two", Shard: 2}
 			}
 
 			return 200, nil
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(proc)
+	processor, err := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
@@ -391,7 +410,7 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
@@ -416,7 +435,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
@@ -442,7 +461,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
@@ -464,7 +483,7 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
@@ -485,7 +504,7 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
@@ -514,7 +533,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
@@ -539,7 +558,7 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
@@ -564,7 +583,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -590,7 +609,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -612,7 +631,7 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -633,7 +652,7 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -661,7 +680,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -686,7 +705,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
@@ -711,7 +730,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -737,7 +756,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -759,7 +778,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -780,7 +799,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -808,7 +827,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -833,7 +852,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
@@ -858,7 +877,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -884,7 +903,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -906,7 +925,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -928,7 +947,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -956,7 +975,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
@@ -986,7 +1005,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
@@ -1015,7 +1034,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -1044,7 +1063,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
@@ -1073,7 +1092,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
@@ -1102,7 +1121,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -1131,7 +1150,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
@@ -1226,7 +1245,7 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 
 	res, err := bp.GetHyperBlockByNonce(4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
@@ -1254,6 +1273,7 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		Code: data.ReturnCodeSuccess,
 		Data: data.HyperblockApiResponsePayload{
 			Hyperblock: expectedHyperBlock,
+			Complete:   true,
 		},
 	}, res)
 	require.NotNil(t, res)
@@ -1343,7 +1363,7 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 
 	res, err := bp.GetHyperBlockByHash("abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
@@ -1371,6 +1391,7 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		Code: data.ReturnCodeSuccess,
 		Data: data.HyperblockApiResponsePayload{
 			Hyperblock: expectedHyperBlock,
+			Complete:   true,
 		},
 	}, res)
 	require.NotNil(t, res)
@@ -1400,7 +1421,7 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochValidatorsInfo(1)
@@ -1408,3 +1429,453 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 }
+
+func TestBlockProcessor_BlocksCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cached block should not be fetched", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, nil
+			},
+		}
+		blocksCache := &mock.BlocksCacheStub{
+			GetCalled: func(key string, value interface{}) bool {
+				assert.Equal(t, "shard_1/block/by-nonce/42?withTxs=true", key)
+				value.(*data.BlockApiResponse).Data.Block.Nonce = 42
+				return true
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+
+		response, err := bp.GetBlockByNonce(1, 42, common.BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		require.Equal(t, uint64(42), response.Data.Block.Nonce)
+	})
+	t.Run("fetched blocks should be stored if final", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.BlockApiResponse)
+				response.Data.Block = api.Block{Nonce: 42, Hash: "abcd"}
+				return 200, nil
+			},
+		}
+		storedKeys := make(map[string]uint64)
+		blocksCache := &mock.BlocksCacheStub{
+			PutIfFinalCalled: func(shardID uint32, nonce uint64, key string, value interface{}) {
+				storedKeys[key] = nonce
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+
+		_, err := bp.GetBlockByHash(1, "abcd", common.BlockQueryOptions{})
+		require.Nil(t, err)
+		_, err = bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{WithLogs: true})
+		require.Nil(t, err)
+
+		expectedKeys := map[string]uint64{
+			"shard_1/block/by-hash/abcd": 42,
+			"shard_4294967295/block/by-nonce/42?forHyperblock=true&withLogs=true&withTxs=true":                                                                                                                         42,
+			"hyperblock_by-nonce/42_{WithLogs:true NotarizedAtSource:false WithAlteredAccounts:false AlteredAccountsOptions:{TokensFilter:} Filters:{Addresses:[] Tokens:[] Functions:[] Status:} AllowPartial:false}": 42,
+		}
+		require.Equal(t, expectedKeys, storedKeys)
+	})
+	t.Run("internal blocks by hash should always be stored", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 200, nil
+			},
+		}
+		storedKeys := make([]string, 0)
+		blocksCache := &mock.BlocksCacheStub{
+			PutCalled: func(key string, value interface{}) {
+				storedKeys = append(storedKeys, key)
+			},
+			PutIfFinalCalled: func(shardID uint32, nonce uint64, key string, value interface{}) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+
+		_, err := bp.GetInternalBlockByHash(0, "abcd", common.Internal)
+		require.Nil(t, err)
+		_, err = bp.GetInternalMiniBlockByHash(0, "dcba", 2, common.Internal)
+		require.Nil(t, err)
+
+		require.Equal(t, []string{
+			"shard_0/internal/json/shardblock/by-hash/abcd",
+			"shard_0/internal/json/miniblock/by-hash/dcba/epoch/2",
+		}, storedKeys)
+	})
+	t.Run("filtered hyperblocks should be stored unfiltered", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.BlockApiResponse)
+				response.Data.Block = api.Block{Nonce: 42, Shard: core.MetachainShardId, MiniBlocks: []*api.MiniBlock{
+					{SourceShard: 0, DestinationShard: core.MetachainShardId, Transactions: []*transaction.ApiTransactionResult{
+						{Hash: "tx1", Sender: "alice"},
+						{Hash: "tx2", Sender: "bob"},
+					}},
+				}}
+				return 200, nil
+			},
+		}
+		storedHyperblocks := make(map[string]*data.HyperblockApiResponse)
+		blocksCache := &mock.BlocksCacheStub{
+			PutIfFinalCalled: func(shardID uint32, nonce uint64, key string, value interface{}) {
+				hyperblockResponse, ok := value.(*data.HyperblockApiResponse)
+				if ok {
+					storedHyperblocks[key] = hyperblockResponse
+				}
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+
+		response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{Filters: common.HyperblockFilters{Addresses: []string{"bob"}}})
+		require.Nil(t, err)
+		require.Len(t, response.Data.Hyperblock.Transactions, 1)
+		require.Equal(t, "tx2", response.Data.Hyperblock.Transactions[0].Hash)
+
+		unfilteredKey := "hyperblock_by-nonce/42_{WithLogs:false NotarizedAtSource:false WithAlteredAccounts:false AlteredAccountsOptions:{TokensFilter:} Filters:{Addresses:[] Tokens:[] Functions:[] Status:} AllowPartial:false}"
+		require.Len(t, storedHyperblocks, 1)
+		require.Len(t, storedHyperblocks[unfilteredKey].Data.Hyperblock.Transactions, 2)
+	})
+	t.Run("blocks and hyperblocks with pending transactions should not be stored", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "observer"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.BlockApiResponse)
+				response.Data.Block = api.Block{Nonce: 42, Hash: "abcd", Shard: core.MetachainShardId, MiniBlocks: []*api.MiniBlock{
+					{SourceShard: core.MetachainShardId, DestinationShard: 0, Transactions: []*transaction.ApiTransactionResult{
+						{Hash: "tx1", Status: transaction.TxStatusSuccess},
+						{Hash: "tx2", Status: transaction.TxStatusPending},
+					}},
+				}}
+				return 200, nil
+			},
+		}
+		blocksCache := &mock.BlocksCacheStub{
+			PutIfFinalCalled: func(shardID uint32, nonce uint64, key string, value interface{}) {
+				assert.Fail(t, "should have not been called", key)
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+
+		_, err := bp.GetBlockByHash(core.MetachainShardId, "abcd", common.BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		_, err = bp.GetBlockByNonce(core.MetachainShardId, 42, common.BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		// only the transactions notarized at source can still be pending in a hyperblock
+		response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{NotarizedAtSource: true})
+		require.Nil(t, err)
+		require.Len(t, response.Data.Hyperblock.Transactions, 2)
+		_, err = bp.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{NotarizedAtSource: true})
+		require.Nil(t, err)
+	})
+}
+
+func TestBlockProcessor_GetHyperBlockWithMissingShardBlocks(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(shardBlockHash string, putIfFinalCalled func()) *process.BlockProcessor {
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: fmt.Sprintf("observer-%d", shardId)}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.BlockApiResponse)
+				switch address {
+				case "observer-4294967295":
+					response.Data.Block = api.Block{Nonce: 42, Hash: "abcd", Shard: core.MetachainShardId, NotarizedBlocks: []*api.NotarizedBlock{
+						{Shard: 0, Nonce: 39, Hash: "zero"},
+						{Shard: 1, Nonce: 40, Hash: "one"},
+					}}
+				case "observer-0":
+					response.Data.Block = api.Block{Nonce: 39, Hash: "zero", Shard: 0}
+				case "observer-1":
+					if len(shardBlockHash) == 0 {
+						return http.StatusInternalServerError, errors.New("observer unavailable")
+					}
+					response.Data.Block = api.Block{Nonce: 40, Hash: shardBlockHash, Shard: 1}
+				}
+
+				return http.StatusOK, nil
+			},
+		}
+		blocksCache := &mock.BlocksCacheStub{
+			PutIfFinalCalled: func(shardID uint32, nonce uint64, key string, value interface{}) {
+				_, isHyperblock := value.(*data.HyperblockApiResponse)
+				if isHyperblock {
+					putIfFinalCalled()
+				}
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+		return bp
+	}
+
+	t.Run("complete hyperblock should be cached", func(t *testing.T) {
+		t.Parallel()
+
+		numPuts := 0
+		bp := createProcessor("one", func() { numPuts++ })
+
+		response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.True(t, response.Data.Complete)
+		require.Empty(t, response.Data.MissingShardBlocks)
+		require.Len(t, response.Data.Hyperblock.ShardBlocks, 2)
+		require.Equal(t, 1, numPuts)
+	})
+	t.Run("unavailable shard block should be reported as missing", func(t *testing.T) {
+		t.Parallel()
+
+		numPuts := 0
+		bp := createProcessor("", func() { numPuts++ })
+
+		response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{AllowPartial: true})
+		require.Nil(t, err)
+		require.False(t, response.Data.Complete)
+		require.Len(t, response.Data.Hyperblock.ShardBlocks, 1)
+		require.Equal(t, "zero", response.Data.Hyperblock.ShardBlocks[0].Hash)
+		require.Len(t, response.Data.MissingShardBlocks, 1)
+		require.Equal(t, uint32(1), response.Data.MissingShardBlocks[0].Shard)
+		require.Equal(t, uint64(40), response.Data.MissingShardBlocks[0].Nonce)
+		require.Equal(t, "one", response.Data.MissingShardBlocks[0].Hash)
+		require.Equal(t, process.ErrSendingRequest.Error(), response.Data.MissingShardBlocks[0].Reason)
+		require.Zero(t, numPuts)
+
+		filteredResponse, err := bp.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{AllowPartial: true, Filters: common.HyperblockFilters{Status: "success"}})
+		require.Nil(t, err)
+		require.False(t, filteredResponse.Data.Complete)
+		require.Len(t, filteredResponse.Data.MissingShardBlocks, 1)
+	})
+	t.Run("mismatching shard block should be reported as missing", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createProcessor("other", func() {})
+
+		response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{AllowPartial: true})
+		require.Nil(t, err)
+		require.False(t, response.Data.Complete)
+		require.Len(t, response.Data.MissingShardBlocks, 1)
+		require.True(t, strings.Contains(response.Data.MissingShardBlocks[0].Reason, "does not match"))
+	})
+	t.Run("incomplete hyperblock should error by default", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createProcessor("", func() {})
+
+		response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, data.ErrIncompleteHyperblock))
+
+		response, err = bp.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, data.ErrIncompleteHyperblock))
+	})
+	t.Run("hyperblocks range should always be strict", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createProcessor("", func() {})
+
+		err := bp.StreamHyperBlocks(42, 42, common.HyperblockQueryOptions{AllowPartial: true}, func(hyperblock *api.Hyperblock) error {
+			require.Fail(t, "incomplete hyperblock should not be handled")
+			return nil
+		})
+		require.True(t, errors.Is(err, data.ErrIncompleteHyperblock))
+	})
+}
+
+func TestBlockProcessor_SetInternalBlocksVerifier(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBlockProcessor(&mock.ProcessorStub{}, &mock.BlocksCacheStub{})
+	require.Equal(t, process.ErrNilInternalBlocksVerifier, bp.SetInternalBlocksVerifier(nil))
+
+	verifier, _ := process.NewInternalBlocksVerifier(marshalizer, hasher)
+	require.Nil(t, bp.SetInternalBlocksVerifier(verifier))
+}
+
+func TestBlockProcessor_GetInternalBlockWithVerification(t *testing.T) {
+	t.Parallel()
+
+	previousBytes, _ := marshalizer.Marshal(&block.HeaderV2{Header: &block.Header{Nonce: 4, ShardID: 1}})
+	previousHash := hasher.Compute(string(previousBytes))
+	goodBytes, _ := marshalizer.Marshal(&block.HeaderV2{Header: &block.Header{Nonce: 5, ShardID: 1, PrevHash: previousHash}})
+	goodHash := hex.EncodeToString(hasher.Compute(string(goodBytes)))
+	badBytes, _ := marshalizer.Marshal(&block.HeaderV2{Header: &block.Header{Nonce: 5, ShardID: 1, PrevHash: []byte("forged")}})
+
+	createProcessor := func(observersBlocks map[string][]byte, requestedPaths *[]string) *process.BlockProcessor {
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				observers := make([]*data.NodeData, 0, len(observersBlocks))
+				for _, address := range []string{"bad", "good"} {
+					_, found := observersBlocks[address]
+					if found {
+						observers = append(observers, &data.NodeData{ShardId: shardId, Address: address})
+					}
+				}
+
+				return observers, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				*requestedPaths = append(*requestedPaths, address+path)
+
+				response := value.(*data.InternalBlockApiResponse)
+				if strings.HasSuffix(path, "/4") {
+					response.Data.Block = base64.StdEncoding.EncodeToString(previousBytes)
+					return http.StatusOK, nil
+				}
+
+				response.Data.Block = base64.StdEncoding.EncodeToString(observersBlocks[address])
+				return http.StatusOK, nil
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, &mock.BlocksCacheStub{})
+		verifier, _ := process.NewInternalBlocksVerifier(marshalizer, hasher)
+		_ = bp.SetInternalBlocksVerifier(verifier)
+
+		return bp
+	}
+
+	t.Run("by hash should skip the observer returning a mismatching block", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		bp := createProcessor(map[string][]byte{"bad": badBytes, "good": goodBytes}, &requestedPaths)
+
+		response, err := bp.GetInternalBlockByHash(1, goodHash, common.Proto)
+		require.Nil(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(goodBytes), response.Data.Block)
+		require.Len(t, requestedPaths, 2)
+	})
+	t.Run("by nonce should check the link to the previous block of another observer", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		bp := createProcessor(map[string][]byte{"bad": badBytes, "good": goodBytes}, &requestedPaths)
+
+		response, err := bp.GetInternalBlockByNonce(1, 5, common.Proto)
+		require.Nil(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(goodBytes), response.Data.Block)
+		require.Equal(t, []string{
+			"bad/internal/raw/shardblock/by-nonce/5",
+			"good/internal/raw/shardblock/by-nonce/4",
+			"good/internal/raw/shardblock/by-nonce/5",
+			"bad/internal/raw/shardblock/by-nonce/4",
+		}, requestedPaths)
+	})
+	t.Run("by nonce with a single observer should check the link to its own previous block", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		bp := createProcessor(map[string][]byte{"good": goodBytes}, &requestedPaths)
+
+		response, err := bp.GetInternalBlockByNonce(1, 5, common.Proto)
+		require.Nil(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(goodBytes), response.Data.Block)
+		require.Equal(t, []string{
+			"good/internal/raw/shardblock/by-nonce/5",
+			"good/internal/raw/shardblock/by-nonce/4",
+		}, requestedPaths)
+	})
+	t.Run("by nonce with a single observer returning a mismatching block should error", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		bp := createProcessor(map[string][]byte{"bad": badBytes}, &requestedPaths)
+
+		response, err := bp.GetInternalBlockByNonce(1, 5, common.Proto)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+		require.Equal(t, []string{
+			"bad/internal/raw/shardblock/by-nonce/5",
+			"bad/internal/raw/shardblock/by-nonce/4",
+		}, requestedPaths)
+	})
+	t.Run("by nonce should use the cached previous block", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "good"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				requestedPaths = append(requestedPaths, address+path)
+				value.(*data.InternalBlockApiResponse).Data.Block = base64.StdEncoding.EncodeToString(goodBytes)
+				return http.StatusOK, nil
+			},
+		}
+		blocksCache := &mock.BlocksCacheStub{
+			GetCalled: func(key string, value interface{}) bool {
+				if key != "shard_1/internal/raw/shardblock/by-nonce/4" {
+					return false
+				}
+
+				value.(*data.InternalBlockApiResponse).Data.Block = base64.StdEncoding.EncodeToString(previousBytes)
+				return true
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, blocksCache)
+		verifier, _ := process.NewInternalBlocksVerifier(marshalizer, hasher)
+		_ = bp.SetInternalBlocksVerifier(verifier)
+
+		response, err := bp.GetInternalBlockByNonce(1, 5, common.Proto)
+		require.Nil(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(goodBytes), response.Data.Block)
+		require.Equal(t, []string{"good/internal/raw/shardblock/by-nonce/5"}, requestedPaths)
+	})
+	t.Run("all observers failing the verification should error", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		bp := createProcessor(map[string][]byte{"bad": badBytes, "good": badBytes}, &requestedPaths)
+
+		response, err := bp.GetInternalBlockByNonce(1, 5, common.Proto)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+
+		response, err = bp.GetInternalBlockByHash(1, goodHash, common.Proto)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+	})
+	t.Run("json format should not be verified", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		bp := createProcessor(map[string][]byte{"bad": badBytes, "good": goodBytes}, &requestedPaths)
+
+		_, err := bp.GetInternalBlockByNonce(1, 5, common.Internal)
+		require.Nil(t, err)
+		require.Equal(t, []string{"bad/internal/json/shardblock/by-nonce/5"}, requestedPaths)
+	})
+}
@@ -0,0 +1,167 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// finalNoncesRefreshInterval defines how often the final nonce of a shard can be fetched from its observers
+const finalNoncesRefreshInterval = time.Second
+
+type finalNonceInfo struct {
+	nonce     uint64
+	isKnown   bool
+	fetchedAt time.Time
+}
+
+type blocksCache struct {
+	proc   Processor
+	cacher ImmutableDataCacheHandler
+
+	mutFinalNonces sync.Mutex
+	finalNonces    map[uint32]*finalNonceInfo
+}
+
+// NewBlocksCache will create a new instance of the blocks cache. The values are stored as JSON, so that they can be
+// spilled to disk by the provided cacher, and only the blocks below the final nonce of their shard are stored
+func NewBlocksCache(proc Processor, cacher ImmutableDataCacheHandler) (*blocksCache, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(cacher) {
+		return nil, ErrNilImmutableDataCache
+	}
+
+	return &blocksCache{
+		proc:        proc,
+		cacher:      cacher,
+		finalNonces: make(map[uint32]*finalNonceInfo),
+	}, nil
+}
+
+// Get loads the value stored under the provided key into the provided value. It returns false if not found
+func (bc *blocksCache) Get(key string, value interface{}) bool {
+	cached, found := bc.cacher.Get(key)
+	if !found {
+		return false
+	}
+
+	buff, ok := cached.([]byte)
+	if !ok {
+		return false
+	}
+
+	return json.Unmarshal(buff, value) == nil
+}
+
+// Put stores the provided value under the provided key. It should only be called for the content addressed data,
+// which never changes
+func (bc *blocksCache) Put(key string, value interface{}) {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("blocks cache: cannot marshal value", "key", key, "error", err.Error())
+		return
+	}
+
+	bc.cacher.Put(key, buff)
+}
+
+// PutIfFinal stores the provided value under the provided key only if the provided nonce is final in the given shard
+func (bc *blocksCache) PutIfFinal(shardID uint32, nonce uint64, key string, value interface{}) {
+	if !bc.isFinal(shardID, nonce) {
+		return
+	}
+
+	bc.Put(key, value)
+}
+
+// isFinal checks the provided nonce against the known final nonce of the shard, refreshing it at most once per refresh
+// interval. The refresh is done outside the lock, so that a slow observer does not hold back the other shards
+func (bc *blocksCache) isFinal(shardID uint32, nonce uint64) bool {
+	isFinal, shouldRefresh := bc.checkKnownFinalNonce(shardID, nonce)
+	if isFinal || !shouldRefresh {
+		return isFinal
+	}
+
+	finalNonce, err := fetchNodeStatusUintMetric(bc.proc, shardID, MetricHighestFinalNonce)
+	if err != nil {
+		log.Debug("blocks cache: cannot fetch final nonce", "shard ID", shardID, "error", err.Error())
+		return false
+	}
+
+	bc.mutFinalNonces.Lock()
+	defer bc.mutFinalNonces.Unlock()
+
+	info := bc.finalNonces[shardID]
+	if finalNonce > info.nonce || !info.isKnown {
+		info.nonce = finalNonce
+		info.isKnown = true
+	}
+
+	return nonce <= info.nonce
+}
+
+// checkKnownFinalNonce returns whether the nonce is below the known final nonce of the shard and, if not, whether
+// the final nonce is due for a refresh. Only the caller getting the refresh flag fetches it
+func (bc *blocksCache) checkKnownFinalNonce(shardID uint32, nonce uint64) (bool, bool) {
+	bc.mutFinalNonces.Lock()
+	defer bc.mutFinalNonces.Unlock()
+
+	info, found := bc.finalNonces[shardID]
+	if !found {
+		info = &finalNonceInfo{}
+		bc.finalNonces[shardID] = info
+	}
+	if info.isKnown && nonce <= info.nonce {
+		return true, false
+	}
+	if time.Since(info.fetchedAt) < finalNoncesRefreshInterval {
+		return false, false
+	}
+
+	info.fetchedAt = time.Now()
+
+	return false, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bc *blocksCache) IsInterfaceNil() bool {
+	return bc == nil
+}
+
+// blockHasPendingTransactions returns true if any transaction of the block is not executed yet on its destination
+// shard. Its status can still change, so the block should not be cached even if final
+func blockHasPendingTransactions(block *api.Block) bool {
+	for _, miniBlock := range block.MiniBlocks {
+		if hasPendingTransactions(miniBlock.Transactions) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPendingTransactions(transactions []*transaction.ApiTransactionResult) bool {
+	for _, tx := range transactions {
+		if tx.Status == transaction.TxStatusPending {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getShardCacheKey(shardID uint32, path string) string {
+	return fmt.Sprintf("shard_%d%s", shardID, path)
+}
+
+func getHyperblockCacheKey(identifier string, options common.HyperblockQueryOptions) string {
+	return fmt.Sprintf("hyperblock_%s_%+v", identifier, options)
+}
@@ -0,0 +1,157 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlocksCache(t *testing.T) {
+	t.Parallel()
+
+	cacher, _ := cache.NewLRUCache(10)
+
+	bc, err := process.NewBlocksCache(nil, cacher)
+	require.Nil(t, bc)
+	require.Equal(t, process.ErrNilCoreProcessor, err)
+
+	bc, err = process.NewBlocksCache(&mock.ProcessorStub{}, nil)
+	require.Nil(t, bc)
+	require.Equal(t, process.ErrNilImmutableDataCache, err)
+
+	bc, err = process.NewBlocksCache(&mock.ProcessorStub{}, cacher)
+	require.Nil(t, err)
+	require.False(t, bc.IsInterfaceNil())
+}
+
+func TestBlocksCache_PutAndGet(t *testing.T) {
+	t.Parallel()
+
+	cacher, _ := cache.NewLRUCache(10)
+	bc, _ := process.NewBlocksCache(&mock.ProcessorStub{}, cacher)
+
+	response := &data.BlockApiResponse{}
+	require.False(t, bc.Get("key", response))
+
+	bc.Put("key", &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: 42, Hash: "abcd"}}})
+	require.True(t, bc.Get("key", response))
+	require.Equal(t, uint64(42), response.Data.Block.Nonce)
+	require.Equal(t, "abcd", response.Data.Block.Hash)
+
+	// the cached value is a copy
+	response.Data.Block.Hash = "changed"
+	otherResponse := &data.BlockApiResponse{}
+	require.True(t, bc.Get("key", otherResponse))
+	require.Equal(t, "abcd", otherResponse.Data.Block.Hash)
+}
+
+func TestBlocksCache_PutIfFinal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should store only the final blocks", func(t *testing.T) {
+		t.Parallel()
+
+		observers := newObserversStub(t)
+		observers.setMetric(process.MetricHighestFinalNonce, 100)
+		proc := observers.processor()
+		proc.GetObserversCalled = func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			assert.Equal(t, data.AvailabilityRecent, dataAvailability)
+			return observers.getNodes(shardId, dataAvailability)
+		}
+		cacher, _ := cache.NewLRUCache(10)
+		bc, _ := process.NewBlocksCache(proc, cacher)
+
+		bc.PutIfFinal(1, 99, "final", &data.BlockApiResponse{})
+		bc.PutIfFinal(1, 100, "final-too", &data.BlockApiResponse{})
+		bc.PutIfFinal(1, 101, "not-final", &data.BlockApiResponse{})
+		require.Equal(t, 2, cacher.Len())
+		require.True(t, bc.Get("final", &data.BlockApiResponse{}))
+		require.True(t, bc.Get("final-too", &data.BlockApiResponse{}))
+		require.False(t, bc.Get("not-final", &data.BlockApiResponse{}))
+
+		// the final nonce is fetched once, and refetched at most once per second, only for the blocks above it
+		require.Equal(t, 1, observers.getNumNodeStatusRequests())
+		observers.setMetric(process.MetricHighestFinalNonce, 200)
+		bc.PutIfFinal(1, 150, "not-final-yet", &data.BlockApiResponse{})
+		require.Equal(t, 1, observers.getNumNodeStatusRequests())
+		require.Equal(t, 2, cacher.Len())
+
+		// each shard has its own final nonce
+		bc.PutIfFinal(2, 150, "other-shard", &data.BlockApiResponse{})
+		require.Equal(t, 2, observers.getNumNodeStatusRequests())
+		require.Equal(t, 3, cacher.Len())
+	})
+	t.Run("final nonce fetching error should not store", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, errors.New("no observers")
+			},
+		}
+		cacher, _ := cache.NewLRUCache(10)
+		bc, _ := process.NewBlocksCache(proc, cacher)
+
+		bc.PutIfFinal(1, 1, "key", &data.BlockApiResponse{})
+		require.Equal(t, 0, cacher.Len())
+	})
+	t.Run("missing metric should not store", func(t *testing.T) {
+		t.Parallel()
+
+		cacher, _ := cache.NewLRUCache(10)
+		bc, _ := process.NewBlocksCache(newObserversStub(t).processor(), cacher)
+
+		bc.PutIfFinal(1, 0, "key", &data.BlockApiResponse{})
+		require.Equal(t, 0, cacher.Len())
+	})
+	t.Run("slow final nonce fetch should not hold back the other shards", func(t *testing.T) {
+		t.Parallel()
+
+		releaseShardOne := make(chan struct{})
+		observers := newObserversStub(t)
+		observers.setMetric(process.MetricHighestFinalNonce, 10)
+		proc := observers.processor()
+		proc.CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			if address == "observer-1" {
+				<-releaseShardOne
+			}
+
+			return observers.callGetRestEndPoint(address, path, value)
+		}
+		cacher, _ := cache.NewLRUCache(10)
+		bc, _ := process.NewBlocksCache(proc, cacher)
+
+		slowPutDone := make(chan struct{})
+		go func() {
+			bc.PutIfFinal(1, 5, "slow", &data.BlockApiResponse{})
+			close(slowPutDone)
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			// waits for the slow shard to be mid fetch, then stores on another shard
+			time.Sleep(10 * time.Millisecond)
+			bc.PutIfFinal(2, 5, "fast", &data.BlockApiResponse{})
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			require.Fail(t, "the final nonce fetch of a shard should not block the other shards")
+		}
+		require.True(t, bc.Get("fast", &data.BlockApiResponse{}))
+
+		close(releaseShardOne)
+		<-slowPutDone
+		require.True(t, bc.Get("slow", &data.BlockApiResponse{}))
+	})
+}
@@ -0,0 +1,64 @@
+package process
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxConcurrentBlockRequests defines how many blocks, or rounds of blocks, of a range can be fetched at the same time
+const maxConcurrentBlockRequests = 10
+
+// StreamBlocks fetches the blocks of a shard between the provided nonces (both included) concurrently and hands them,
+// in nonce order, to the provided handler. The streaming stops at the first fetching or handler error
+func (bp *BlockProcessor) StreamBlocks(
+	shardID uint32,
+	fromNonce uint64,
+	toNonce uint64,
+	options common.BlockQueryOptions,
+	handler func(block *api.Block) error,
+) error {
+	if fromNonce > toNonce || toNonce-fromNonce >= common.MaxBlocksRangeSize {
+		return fmt.Errorf("%w: from nonce %d to nonce %d, at most %d blocks allowed",
+			ErrInvalidBlocksRange, fromNonce, toNonce, common.MaxBlocksRangeSize)
+	}
+
+	fetchBlock := func(nonce uint64) (*api.Block, error) {
+		response, err := bp.GetBlockByNonce(shardID, nonce, options)
+		if err != nil {
+			return nil, fmt.Errorf("%w for shard %d, nonce %d", err, shardID, nonce)
+		}
+
+		return &response.Data.Block, nil
+	}
+
+	return fetchOrdered(fromNonce, toNonce, maxConcurrentBlockRequests, fetchBlock, handler)
+}
+
+// StreamBlocksByRound fetches the blocks, from all shards, of the rounds between the provided ones (both included)
+// concurrently and hands them, in round order, to the provided handler. As for GetBlocksByRound, a shard without a
+// block in a round is skipped. The streaming stops at the first fetching or handler error
+func (bp *BlocksProcessor) StreamBlocksByRound(
+	fromRound uint64,
+	toRound uint64,
+	options common.BlockQueryOptions,
+	handler func(roundBlocks *data.RoundBlocks) error,
+) error {
+	if fromRound > toRound || toRound-fromRound >= common.MaxBlocksRangeSize {
+		return fmt.Errorf("%w: from round %d to round %d, at most %d rounds allowed",
+			ErrInvalidBlocksRange, fromRound, toRound, common.MaxBlocksRangeSize)
+	}
+
+	fetchRoundBlocks := func(round uint64) (*data.RoundBlocks, error) {
+		response, err := bp.GetBlocksByRound(round, options)
+		if err != nil {
+			return nil, fmt.Errorf("%w for round %d", err, round)
+		}
+
+		return &data.RoundBlocks{Round: round, Blocks: response.Data.Blocks}, nil
+	}
+
+	return fetchOrdered(fromRound, toRound, maxConcurrentBlockRequests, fetchRoundBlocks, handler)
+}
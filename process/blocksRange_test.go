@@ -0,0 +1,214 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBlocksRangeObservers(t *testing.T, pathFormat string, delay func(value uint64) time.Duration, failingValue uint64) *observersStub {
+	observers := newObserversStub(t, 0, 1)
+	observers.handle(strings.TrimSuffix(pathFormat, "%d"), func(shardID uint32, path string, value interface{}) (int, error) {
+		requested := uint64(0)
+		_, err := fmt.Sscanf(path, pathFormat, &requested)
+		assert.Nil(t, err)
+		assert.True(t, strings.HasSuffix(path, "?withLogs=true&withTxs=true"), path)
+
+		time.Sleep(delay(requested))
+		if requested == failingValue {
+			return 0, errors.New("observer went offline")
+		}
+
+		response := value.(*data.BlockApiResponse)
+		response.Data = data.BlockApiResponsePayload{Block: api.Block{
+			Nonce: requested,
+			Round: requested,
+			Shard: shardID,
+			Hash:  fmt.Sprintf("hash-%d-%d", shardID, requested),
+		}}
+		return 200, nil
+	})
+
+	return observers
+}
+
+func TestBlockProcessor_StreamBlocks(t *testing.T) {
+	t.Parallel()
+
+	options := common.BlockQueryOptions{WithTransactions: true, WithLogs: true}
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newBlocksRangeObservers(t, "/block/by-nonce/%d", noDelay, 0).processor(), &mock.BlocksCacheStub{})
+		handler := func(block *api.Block) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+
+		err := bp.StreamBlocks(0, 10, 9, options, handler)
+		require.True(t, errors.Is(err, process.ErrInvalidBlocksRange))
+
+		err = bp.StreamBlocks(0, 10, 10+common.MaxBlocksRangeSize, options, handler)
+		require.True(t, errors.Is(err, process.ErrInvalidBlocksRange))
+	})
+	t.Run("should hand the blocks in nonce order", func(t *testing.T) {
+		t.Parallel()
+
+		// the lower the nonce, the later its block is fetched
+		delayForNonce := func(nonce uint64) time.Duration {
+			return time.Duration(30-nonce) * time.Millisecond
+		}
+		bp, _ := process.NewBlockProcessor(newBlocksRangeObservers(t, "/block/by-nonce/%d", delayForNonce, 0).processor(), &mock.BlocksCacheStub{})
+
+		nonces := make([]uint64, 0)
+		err := bp.StreamBlocks(1, 1, 25, options, func(block *api.Block) error {
+			nonces = append(nonces, block.Nonce)
+			assert.Equal(t, fmt.Sprintf("hash-1-%d", block.Nonce), block.Hash)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, nonces, 25)
+		for i, nonce := range nonces {
+			require.Equal(t, uint64(i+1), nonce)
+		}
+	})
+	t.Run("fetching error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newBlocksRangeObservers(t, "/block/by-nonce/%d", noDelay, 12).processor(), &mock.BlocksCacheStub{})
+
+		nonces := make([]uint64, 0)
+		err := bp.StreamBlocks(0, 10, 20, options, func(block *api.Block) error {
+			nonces = append(nonces, block.Nonce)
+			return nil
+		})
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.True(t, strings.Contains(err.Error(), "for shard 0, nonce 12"))
+		require.Equal(t, []uint64{10, 11}, nonces)
+	})
+	t.Run("handler error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		numFetched := uint32(0)
+		delayForNonce := func(nonce uint64) time.Duration {
+			atomic.AddUint32(&numFetched, 1)
+			return time.Millisecond
+		}
+		bp, _ := process.NewBlockProcessor(newBlocksRangeObservers(t, "/block/by-nonce/%d", delayForNonce, 0).processor(), &mock.BlocksCacheStub{})
+
+		expectedErr := errors.New("client went away")
+		numHandled := 0
+		err := bp.StreamBlocks(0, 1, 50, options, func(block *api.Block) error {
+			numHandled++
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 1, numHandled)
+
+		time.Sleep(50 * time.Millisecond)
+		require.Less(t, atomic.LoadUint32(&numFetched), uint32(50))
+	})
+}
+
+func TestBlocksProcessor_StreamBlocksByRound(t *testing.T) {
+	t.Parallel()
+
+	options := common.BlockQueryOptions{WithTransactions: true, WithLogs: true}
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlocksProcessor(newBlocksRangeObservers(t, "/block/by-round/%d", noDelay, 0).processor())
+		handler := func(roundBlocks *data.RoundBlocks) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+
+		err := bp.StreamBlocksByRound(10, 9, options, handler)
+		require.True(t, errors.Is(err, process.ErrInvalidBlocksRange))
+
+		err = bp.StreamBlocksByRound(10, 10+common.MaxBlocksRangeSize, options, handler)
+		require.True(t, errors.Is(err, process.ErrInvalidBlocksRange))
+	})
+	t.Run("should hand the blocks of all shards in round order", func(t *testing.T) {
+		t.Parallel()
+
+		// the lower the round, the later its blocks are fetched
+		delayForRound := func(round uint64) time.Duration {
+			return time.Duration(30-round) * time.Millisecond
+		}
+		bp, _ := process.NewBlocksProcessor(newBlocksRangeObservers(t, "/block/by-round/%d", delayForRound, 0).processor())
+
+		rounds := make([]uint64, 0)
+		err := bp.StreamBlocksByRound(1, 25, options, func(roundBlocks *data.RoundBlocks) error {
+			rounds = append(rounds, roundBlocks.Round)
+			require.Len(t, roundBlocks.Blocks, 2)
+			for idx, block := range roundBlocks.Blocks {
+				assert.Equal(t, roundBlocks.Round, block.Round)
+				assert.Equal(t, fmt.Sprintf("hash-%d-%d", idx, roundBlocks.Round), block.Hash)
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, rounds, 25)
+		for i, round := range rounds {
+			require.Equal(t, uint64(i+1), round)
+		}
+	})
+	t.Run("round without blocks should be handed empty", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlocksProcessor(newBlocksRangeObservers(t, "/block/by-round/%d", noDelay, 12).processor())
+
+		numBlocksPerRound := make(map[uint64]int)
+		err := bp.StreamBlocksByRound(10, 14, options, func(roundBlocks *data.RoundBlocks) error {
+			numBlocksPerRound[roundBlocks.Round] = len(roundBlocks.Blocks)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, map[uint64]int{10: 2, 11: 2, 12: 0, 13: 2, 14: 2}, numBlocksPerRound)
+	})
+	t.Run("fetching error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("no observers")
+		proc := newBlocksRangeObservers(t, "/block/by-round/%d", noDelay, 0).processor()
+		proc.GetObserversCalled = func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return nil, expectedErr
+		}
+		bp, _ := process.NewBlocksProcessor(proc)
+
+		err := bp.StreamBlocksByRound(10, 20, options, func(roundBlocks *data.RoundBlocks) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		})
+		require.True(t, errors.Is(err, expectedErr))
+		require.True(t, strings.Contains(err.Error(), "for round 10"))
+	})
+	t.Run("handler error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlocksProcessor(newBlocksRangeObservers(t, "/block/by-round/%d", noDelay, 0).processor())
+
+		expectedErr := errors.New("client went away")
+		numHandled := 0
+		err := bp.StreamBlocksByRound(1, 50, options, func(roundBlocks *data.RoundBlocks) error {
+			numHandled++
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 1, numHandled)
+	})
+}
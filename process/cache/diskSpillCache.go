@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// spilledEntriesDirectory is the directory, created inside the configured one, that holds the entries spilled to disk.
+// It is emptied when the cache is created, as the entries of a previous run are not indexed anymore
+const spilledEntriesDirectory = "spilled-entries"
+
+// DiskSpillCache is a size-bounded cache of byte slices that, instead of dropping the least recently used entries
+// when its memory is full, moves them to disk, until the on-disk capacity is reached as well. Values of any other
+// type are only kept in memory
+type DiskSpillCache struct {
+	memory    *LRUCache
+	spilled   *LRUCache
+	directory string
+	mut       sync.Mutex
+}
+
+// NewDiskSpillCache will return a new instance of DiskSpillCache that holds at most memoryCapacity entries in memory
+// and diskCapacity entries in files under the provided directory
+func NewDiskSpillCache(memoryCapacity int, diskCapacity int, directory string) (*DiskSpillCache, error) {
+	if len(directory) == 0 {
+		return nil, ErrInvalidSpillDirectory
+	}
+
+	memory, err := NewLRUCache(memoryCapacity)
+	if err != nil {
+		return nil, err
+	}
+	spilled, err := NewLRUCache(diskCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	spillDirectory := filepath.Join(directory, spilledEntriesDirectory)
+	err = os.RemoveAll(spillDirectory)
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(spillDirectory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	dsc := &DiskSpillCache{
+		memory:    memory,
+		spilled:   spilled,
+		directory: spillDirectory,
+	}
+	memory.SetEvictionHandler(dsc.spill)
+	spilled.SetEvictionHandler(func(_ string, value interface{}) {
+		_ = os.Remove(value.(string))
+	})
+
+	return dsc, nil
+}
+
+// Get returns the value stored under the provided key, if found either in memory or on disk. The entries found on
+// disk are moved back to memory
+func (dsc *DiskSpillCache) Get(key string) (interface{}, bool) {
+	value, found := dsc.memory.Get(key)
+	if found {
+		return value, true
+	}
+
+	dsc.mut.Lock()
+	defer dsc.mut.Unlock()
+
+	path, found := dsc.spilled.Get(key)
+	if !found {
+		return nil, false
+	}
+
+	dsc.spilled.Remove(key)
+	buff, err := os.ReadFile(path.(string))
+	_ = os.Remove(path.(string))
+	if err != nil {
+		return nil, false
+	}
+
+	dsc.putInMemory(key, buff)
+
+	return buff, true
+}
+
+// Put stores the value under the provided key in memory, moving the least recently used entry to disk if needed
+func (dsc *DiskSpillCache) Put(key string, value interface{}) {
+	dsc.mut.Lock()
+	defer dsc.mut.Unlock()
+
+	dsc.putInMemory(key, value)
+}
+
+func (dsc *DiskSpillCache) putInMemory(key string, value interface{}) {
+	path, found := dsc.spilled.Get(key)
+	if found {
+		dsc.spilled.Remove(key)
+		_ = os.Remove(path.(string))
+	}
+
+	dsc.memory.Put(key, value)
+}
+
+// spill is called, under the cache lock, with each entry evicted from memory
+func (dsc *DiskSpillCache) spill(key string, value interface{}) {
+	buff, ok := value.([]byte)
+	if !ok {
+		return
+	}
+
+	hash := sha256.Sum256([]byte(key))
+	path := filepath.Join(dsc.directory, hex.EncodeToString(hash[:]))
+	err := os.WriteFile(path, buff, 0600)
+	if err != nil {
+		return
+	}
+
+	dsc.spilled.Put(key, path)
+}
+
+// Len returns the number of entries stored in cache, both in memory and on disk
+func (dsc *DiskSpillCache) Len() int {
+	dsc.mut.Lock()
+	defer dsc.mut.Unlock()
+
+	return dsc.memory.Len() + dsc.spilled.Len()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (dsc *DiskSpillCache) IsInterfaceNil() bool {
+	return dsc == nil
+}
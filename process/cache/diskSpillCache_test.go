@@ -0,0 +1,155 @@
+package cache_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func numSpilledFiles(t *testing.T, directory string) int {
+	entries, err := os.ReadDir(filepath.Join(directory, "spilled-entries"))
+	require.Nil(t, err)
+
+	return len(entries)
+}
+
+func TestNewDiskSpillCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		dsc, err := cache.NewDiskSpillCache(10, 10, "")
+		require.Nil(t, dsc)
+		require.Equal(t, cache.ErrInvalidSpillDirectory, err)
+	})
+	t.Run("invalid capacities should error", func(t *testing.T) {
+		t.Parallel()
+
+		dsc, err := cache.NewDiskSpillCache(0, 10, t.TempDir())
+		require.Nil(t, dsc)
+		require.Equal(t, cache.ErrInvalidCacheCapacity, err)
+
+		dsc, err = cache.NewDiskSpillCache(10, 0, t.TempDir())
+		require.Nil(t, dsc)
+		require.Equal(t, cache.ErrInvalidCacheCapacity, err)
+	})
+	t.Run("should remove the entries spilled by a previous run", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		staleFile := filepath.Join(directory, "spilled-entries", "stale")
+		require.Nil(t, os.MkdirAll(filepath.Dir(staleFile), os.ModePerm))
+		require.Nil(t, os.WriteFile(staleFile, []byte("stale"), 0600))
+
+		dsc, err := cache.NewDiskSpillCache(10, 10, directory)
+		require.Nil(t, err)
+		require.False(t, dsc.IsInterfaceNil())
+		require.Equal(t, 0, dsc.Len())
+		require.Equal(t, 0, numSpilledFiles(t, directory))
+	})
+}
+
+func TestDiskSpillCache_PutAndGet(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	dsc, _ := cache.NewDiskSpillCache(2, 2, directory)
+
+	dsc.Put("a", []byte("1"))
+	dsc.Put("b", []byte("2"))
+	dsc.Put("c", []byte("3"))
+	require.Equal(t, 3, dsc.Len())
+	require.Equal(t, 1, numSpilledFiles(t, directory))
+
+	// "a" is read back from disk, moving "b" to disk in its place
+	value, found := dsc.Get("a")
+	require.True(t, found)
+	require.Equal(t, []byte("1"), value)
+	require.Equal(t, 3, dsc.Len())
+	require.Equal(t, 1, numSpilledFiles(t, directory))
+
+	dsc.Put("d", []byte("4"))
+	dsc.Put("e", []byte("5"))
+	// memory holds "d" and "e", disk holds the two most recently spilled entries, "a" and "c"
+	require.Equal(t, 4, dsc.Len())
+	require.Equal(t, 2, numSpilledFiles(t, directory))
+	_, found = dsc.Get("b")
+	require.False(t, found)
+
+	for key, expectedValue := range map[string]string{"a": "1", "c": "3", "d": "4", "e": "5"} {
+		value, found = dsc.Get(key)
+		require.True(t, found, key)
+		require.Equal(t, []byte(expectedValue), value, key)
+	}
+}
+
+func TestDiskSpillCache_ValuesOtherThanBytesShouldNotBeSpilled(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	dsc, _ := cache.NewDiskSpillCache(1, 2, directory)
+
+	dsc.Put("a", 1)
+	dsc.Put("b", 2)
+	require.Equal(t, 1, dsc.Len())
+	require.Equal(t, 0, numSpilledFiles(t, directory))
+	_, found := dsc.Get("a")
+	require.False(t, found)
+}
+
+func TestDiskSpillCache_PutShouldOverwriteSpilledEntry(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	dsc, _ := cache.NewDiskSpillCache(1, 2, directory)
+
+	dsc.Put("a", []byte("1"))
+	dsc.Put("b", []byte("2"))
+	dsc.Put("a", []byte("10"))
+	require.Equal(t, 2, dsc.Len())
+	require.Equal(t, 1, numSpilledFiles(t, directory))
+
+	value, found := dsc.Get("a")
+	require.True(t, found)
+	require.Equal(t, []byte("10"), value)
+}
+
+func TestDiskSpillCache_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	dsc, _ := cache.NewDiskSpillCache(10, 20, t.TempDir())
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := fmt.Sprintf("key%d", idx%50)
+			if idx%2 == 0 {
+				dsc.Put(key, []byte(key))
+				return
+			}
+
+			value, found := dsc.Get(key)
+			if found {
+				assert.Equal(t, []byte(key), value)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, dsc.Len(), 30)
+}
@@ -19,3 +19,12 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrInvalidCacheCapacity signals that the provided cache capacity is invalid
+var ErrInvalidCacheCapacity = errors.New("invalid cache capacity")
+
+// ErrInvalidTimeToLive signals that the provided time to live of the cache entries is invalid
+var ErrInvalidTimeToLive = errors.New("invalid time to live")
+
+// ErrInvalidSpillDirectory signals that the provided directory for the entries spilled to disk is invalid
+var ErrInvalidSpillDirectory = errors.New("invalid spill directory")
@@ -1,6 +1,10 @@
 package cache
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 func (hmc *HeartbeatMemoryCacher) GetStoredHbts() []data.PubKeyHeartbeat {
 	hmc.mutHeartbeats.RLock()
@@ -40,3 +44,7 @@ func (garmc *genericApiResponseMemoryCacher) SetGenericApiResponse(response *dat
 	garmc.storedResponse = response
 	garmc.mutGenericApiResponse.Unlock()
 }
+
+func (tc *TTLCache) SetCurrentTimeHandler(handler func() time.Time) {
+	tc.currentTimeHandler = handler
+}
@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+type lruCacheEntry struct {
+	key   string
+	value interface{}
+}
+
+// LRUCache is a size-bounded, in-memory cache that evicts the least recently used entries
+type LRUCache struct {
+	capacity        int
+	entries         map[string]*list.Element
+	order           *list.List
+	mut             sync.Mutex
+	evictionHandler func(key string, value interface{})
+}
+
+// NewLRUCache will return a new instance of LRUCache that holds at most capacity entries
+func NewLRUCache(capacity int) (*LRUCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidCacheCapacity
+	}
+
+	return &LRUCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}, nil
+}
+
+// Get returns the value stored under the provided key (if found) and marks it as the most recently used
+func (lc *LRUCache) Get(key string) (interface{}, bool) {
+	lc.mut.Lock()
+	defer lc.mut.Unlock()
+
+	element, found := lc.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	lc.order.MoveToFront(element)
+
+	return element.Value.(*lruCacheEntry).value, true
+}
+
+// Put stores the value under the provided key, evicting the least recently used entry if the cache is full
+func (lc *LRUCache) Put(key string, value interface{}) {
+	evicted := lc.put(key, value)
+	if evicted == nil || lc.evictionHandler == nil {
+		return
+	}
+
+	lc.evictionHandler(evicted.key, evicted.value)
+}
+
+func (lc *LRUCache) put(key string, value interface{}) *lruCacheEntry {
+	lc.mut.Lock()
+	defer lc.mut.Unlock()
+
+	element, found := lc.entries[key]
+	if found {
+		element.Value.(*lruCacheEntry).value = value
+		lc.order.MoveToFront(element)
+		return nil
+	}
+
+	lc.entries[key] = lc.order.PushFront(&lruCacheEntry{key: key, value: value})
+	if lc.order.Len() <= lc.capacity {
+		return nil
+	}
+
+	oldest := lc.order.Back()
+	lc.order.Remove(oldest)
+	evicted := oldest.Value.(*lruCacheEntry)
+	delete(lc.entries, evicted.key)
+
+	return evicted
+}
+
+// Remove deletes the entry stored under the provided key, if any, without calling the eviction handler
+func (lc *LRUCache) Remove(key string) {
+	lc.mut.Lock()
+	defer lc.mut.Unlock()
+
+	element, found := lc.entries[key]
+	if !found {
+		return
+	}
+
+	lc.order.Remove(element)
+	delete(lc.entries, key)
+}
+
+// SetEvictionHandler sets the function called, outside the cache lock, with each entry evicted because the cache
+// was full. It should be set before the cache is used
+func (lc *LRUCache) SetEvictionHandler(handler func(key string, value interface{})) {
+	lc.evictionHandler = handler
+}
+
+// Len returns the number of entries stored in cache
+func (lc *LRUCache) Len() int {
+	lc.mut.Lock()
+	defer lc.mut.Unlock()
+
+	return lc.order.Len()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (lc *LRUCache) IsInterfaceNil() bool {
+	return lc == nil
+}
@@ -0,0 +1,122 @@
+package cache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLRUCache(t *testing.T) {
+	t.Parallel()
+
+	lc, err := cache.NewLRUCache(0)
+	require.Nil(t, lc)
+	require.Equal(t, cache.ErrInvalidCacheCapacity, err)
+
+	lc, err = cache.NewLRUCache(10)
+	require.Nil(t, err)
+	require.False(t, lc.IsInterfaceNil())
+	require.Equal(t, 0, lc.Len())
+}
+
+func TestLRUCache_PutAndGet(t *testing.T) {
+	t.Parallel()
+
+	lc, _ := cache.NewLRUCache(2)
+
+	value, found := lc.Get("missing")
+	require.False(t, found)
+	require.Nil(t, value)
+
+	lc.Put("a", 1)
+	lc.Put("b", 2)
+	value, found = lc.Get("a")
+	require.True(t, found)
+	require.Equal(t, 1, value)
+
+	// "b" is the least recently used entry, so it gets evicted
+	lc.Put("c", 3)
+	require.Equal(t, 2, lc.Len())
+	_, found = lc.Get("b")
+	require.False(t, found)
+
+	lc.Put("a", 10)
+	value, _ = lc.Get("a")
+	require.Equal(t, 10, value)
+	value, _ = lc.Get("c")
+	require.Equal(t, 3, value)
+	require.Equal(t, 2, lc.Len())
+}
+
+func TestLRUCache_Remove(t *testing.T) {
+	t.Parallel()
+
+	lc, _ := cache.NewLRUCache(2)
+	lc.Put("a", 1)
+	lc.Put("b", 2)
+
+	lc.Remove("a")
+	lc.Remove("missing")
+	require.Equal(t, 1, lc.Len())
+	_, found := lc.Get("a")
+	require.False(t, found)
+
+	lc.Put("c", 3)
+	value, found := lc.Get("b")
+	require.True(t, found)
+	require.Equal(t, 2, value)
+}
+
+func TestLRUCache_EvictionHandler(t *testing.T) {
+	t.Parallel()
+
+	evicted := make(map[string]interface{})
+	lc, _ := cache.NewLRUCache(2)
+	lc.SetEvictionHandler(func(key string, value interface{}) {
+		evicted[key] = value
+	})
+
+	lc.Put("a", 1)
+	lc.Put("b", 2)
+	lc.Put("a", 10)
+	lc.Remove("b")
+	require.Empty(t, evicted)
+
+	lc.Put("c", 3)
+	lc.Put("d", 4)
+	require.Equal(t, map[string]interface{}{"a": 10}, evicted)
+}
+
+func TestLRUCache_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	lc, _ := cache.NewLRUCache(50)
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := fmt.Sprintf("key%d", idx%100)
+			if idx%2 == 0 {
+				lc.Put(key, idx)
+				return
+			}
+
+			_, _ = lc.Get(key)
+		}(i)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, lc.Len(), 50)
+}
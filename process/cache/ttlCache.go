@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"time"
+)
+
+type ttlCacheEntry struct {
+	value      interface{}
+	expiryTime time.Time
+}
+
+// TTLCache is a size-bounded, in-memory cache whose entries expire after a configured time to live
+type TTLCache struct {
+	entries            *LRUCache
+	timeToLive         time.Duration
+	currentTimeHandler func() time.Time
+}
+
+// NewTTLCache will return a new instance of TTLCache that holds at most capacity entries, each one for timeToLive
+func NewTTLCache(capacity int, timeToLive time.Duration) (*TTLCache, error) {
+	if timeToLive <= 0 {
+		return nil, ErrInvalidTimeToLive
+	}
+
+	entries, err := NewLRUCache(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TTLCache{
+		entries:            entries,
+		timeToLive:         timeToLive,
+		currentTimeHandler: time.Now,
+	}, nil
+}
+
+// Get returns the value stored under the provided key, if found and not expired
+func (tc *TTLCache) Get(key string) (interface{}, bool) {
+	value, found := tc.entries.Get(key)
+	if !found {
+		return nil, false
+	}
+
+	entry := value.(*ttlCacheEntry)
+	if tc.currentTimeHandler().After(entry.expiryTime) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// Put stores the value under the provided key, for the configured time to live
+func (tc *TTLCache) Put(key string, value interface{}) {
+	tc.entries.Put(key, &ttlCacheEntry{
+		value:      value,
+		expiryTime: tc.currentTimeHandler().Add(tc.timeToLive),
+	})
+}
+
+// Len returns the number of entries stored in cache, including the expired ones not yet evicted
+func (tc *TTLCache) Len() int {
+	return tc.entries.Len()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (tc *TTLCache) IsInterfaceNil() bool {
+	return tc == nil
+}
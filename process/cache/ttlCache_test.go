@@ -0,0 +1,70 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTTLCache(t *testing.T) {
+	t.Parallel()
+
+	tc, err := cache.NewTTLCache(10, 0)
+	require.Nil(t, tc)
+	require.Equal(t, cache.ErrInvalidTimeToLive, err)
+
+	tc, err = cache.NewTTLCache(0, time.Second)
+	require.Nil(t, tc)
+	require.Equal(t, cache.ErrInvalidCacheCapacity, err)
+
+	tc, err = cache.NewTTLCache(10, time.Second)
+	require.Nil(t, err)
+	require.False(t, tc.IsInterfaceNil())
+	require.Equal(t, 0, tc.Len())
+}
+
+func TestTTLCache_EntriesShouldExpire(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tc, _ := cache.NewTTLCache(10, time.Minute)
+	tc.SetCurrentTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	tc.Put("key", "value")
+	value, found := tc.Get("key")
+	require.True(t, found)
+	require.Equal(t, "value", value)
+
+	currentTime = currentTime.Add(time.Minute)
+	value, found = tc.Get("key")
+	require.True(t, found)
+	require.Equal(t, "value", value)
+
+	currentTime = currentTime.Add(time.Second)
+	value, found = tc.Get("key")
+	require.False(t, found)
+	require.Nil(t, value)
+
+	tc.Put("key", "new value")
+	value, found = tc.Get("key")
+	require.True(t, found)
+	require.Equal(t, "new value", value)
+	require.Equal(t, 1, tc.Len())
+}
+
+func TestTTLCache_ShouldEvictWhenFull(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := cache.NewTTLCache(2, time.Minute)
+	tc.Put("key1", 1)
+	tc.Put("key2", 2)
+	tc.Put("key3", 3)
+
+	_, found := tc.Get("key1")
+	require.False(t, found)
+	require.Equal(t, 2, tc.Len())
+}
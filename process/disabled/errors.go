@@ -0,0 +1,6 @@
+package disabled
+
+import "errors"
+
+// ErrWebhooksNotEnabled signals that the webhooks are not enabled in the configuration
+var ErrWebhooksNotEnabled = errors.New("webhooks are not enabled")
@@ -0,0 +1,32 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// WebhooksProcessor represents a disabled struct that implements the WebhooksProcessor interface
+type WebhooksProcessor struct {
+}
+
+// RegisterWebhook returns ErrWebhooksNotEnabled as this is a disabled component
+func (wp *WebhooksProcessor) RegisterWebhook(_ string, _ data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+	return nil, ErrWebhooksNotEnabled
+}
+
+// GetWebhooks returns an empty slice as this is a disabled component
+func (wp *WebhooksProcessor) GetWebhooks(_ string) []*data.WebhookSubscription {
+	return make([]*data.WebhookSubscription, 0)
+}
+
+// RemoveWebhook returns ErrWebhooksNotEnabled as this is a disabled component
+func (wp *WebhooksProcessor) RemoveWebhook(_ string, _ string) error {
+	return ErrWebhooksNotEnabled
+}
+
+// GetWebhookDeliveries returns ErrWebhooksNotEnabled as this is a disabled component
+func (wp *WebhooksProcessor) GetWebhookDeliveries(_ string, _ string) ([]*data.WebhookDelivery, error) {
+	return nil, ErrWebhooksNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wp *WebhooksProcessor) IsInterfaceNil() bool {
+	return wp == nil
+}
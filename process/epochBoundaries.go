@@ -0,0 +1,236 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// startOfEpochMetaBlock holds the fields of the internal start of epoch metablock used as an epoch boundary
+type startOfEpochMetaBlock struct {
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	TimeStamp uint64 `json:"timeStamp"`
+}
+
+// currentEpochs fetches the current epoch of each shard at most once per request
+type currentEpochs struct {
+	proc   Processor
+	epochs map[uint32]uint64
+}
+
+func newCurrentEpochs(proc Processor) *currentEpochs {
+	return &currentEpochs{
+		proc:   proc,
+		epochs: make(map[uint32]uint64),
+	}
+}
+
+func (ce *currentEpochs) get(shardID uint32) (uint64, error) {
+	epoch, found := ce.epochs[shardID]
+	if found {
+		return epoch, nil
+	}
+
+	epoch, err := fetchNodeStatusUintMetric(ce.proc, shardID, MetricEpochNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	ce.epochs[shardID] = epoch
+	return epoch, nil
+}
+
+// GetEpochBoundaries returns the first and the last block of the provided epoch, for each shard. The boundaries of
+// the past epochs never change, so they are kept for good once all the shards moved past the epoch
+func (bp *BlockProcessor) GetEpochBoundaries(epoch uint32) (*data.EpochBoundariesApiResponse, error) {
+	boundaries, err := bp.getEpochBoundaries(epoch, newCurrentEpochs(bp.proc))
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.EpochBoundariesApiResponse{
+		Data: data.EpochBoundariesApiResponsePayload{Epoch: boundaries},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+// GetEpochsBoundaries returns the boundaries of the epochs between the provided ones, both included
+func (bp *BlockProcessor) GetEpochsBoundaries(fromEpoch uint32, toEpoch uint32) (*data.EpochsBoundariesApiResponse, error) {
+	if fromEpoch > toEpoch || toEpoch-fromEpoch >= common.MaxEpochsRangeSize {
+		return nil, fmt.Errorf("%w: at most %d epochs can be requested at once", ErrInvalidEpochsRange, common.MaxEpochsRangeSize)
+	}
+
+	epochs := make([]*data.EpochBoundaries, 0, toEpoch-fromEpoch+1)
+	current := newCurrentEpochs(bp.proc)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		boundaries, err := bp.getEpochBoundaries(epoch, current)
+		if err != nil {
+			return nil, fmt.Errorf("%w for epoch %d", err, epoch)
+		}
+
+		epochs = append(epochs, boundaries)
+	}
+
+	return &data.EpochsBoundariesApiResponse{
+		Data: data.EpochsBoundariesApiResponsePayload{Epochs: epochs},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+func (bp *BlockProcessor) getEpochBoundaries(epoch uint32, current *currentEpochs) (*data.EpochBoundaries, error) {
+	bp.mutPastEpochs.RLock()
+	boundaries, found := bp.pastEpochs[epoch]
+	bp.mutPastEpochs.RUnlock()
+	if found {
+		return boundaries, nil
+	}
+
+	metaEpoch, err := current.get(core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(epoch) > metaEpoch {
+		return nil, fmt.Errorf("%w: current epoch is %d", ErrEpochNotStarted, metaEpoch)
+	}
+
+	boundaries = &data.EpochBoundaries{
+		Epoch:      epoch,
+		IsComplete: true,
+		Shards:     make([]*data.ShardEpochBoundaries, 0),
+	}
+	for _, shardID := range bp.proc.GetShardIDs() {
+		shardBoundaries, errShard := bp.getShardEpochBoundaries(shardID, epoch, current)
+		if errShard != nil {
+			return nil, fmt.Errorf("%w for shard %d", errShard, shardID)
+		}
+
+		boundaries.IsComplete = boundaries.IsComplete && shardBoundaries.Last != nil
+		boundaries.Shards = append(boundaries.Shards, shardBoundaries)
+	}
+
+	if boundaries.IsComplete {
+		bp.mutPastEpochs.Lock()
+		bp.pastEpochs[epoch] = boundaries
+		bp.mutPastEpochs.Unlock()
+	}
+
+	return boundaries, nil
+}
+
+// getShardEpochBoundaries returns the first block of the shard in the epoch, along with the last one, which precedes
+// the first block of the next epoch
+func (bp *BlockProcessor) getShardEpochBoundaries(shardID uint32, epoch uint32, current *currentEpochs) (*data.ShardEpochBoundaries, error) {
+	shardBoundaries := &data.ShardEpochBoundaries{
+		ShardID: shardID,
+	}
+
+	currentEpoch, err := current.get(shardID)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(epoch) > currentEpoch {
+		return shardBoundaries, nil
+	}
+
+	shardBoundaries.First, err = bp.getFirstBlockOfEpoch(shardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(epoch) == currentEpoch {
+		return shardBoundaries, nil
+	}
+
+	nextEpochFirstBlock, err := bp.getFirstBlockOfEpoch(shardID, epoch+1)
+	if err != nil {
+		return nil, err
+	}
+	shardBoundaries.Last, err = bp.getEpochBlockBoundary(shardID, nextEpochFirstBlock.Nonce-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return shardBoundaries, nil
+}
+
+func (bp *BlockProcessor) getFirstBlockOfEpoch(shardID uint32, epoch uint32) (*data.EpochBlockBoundary, error) {
+	// the genesis block has no epoch-start data
+	if epoch == 0 {
+		return bp.getEpochBlockBoundary(shardID, 0)
+	}
+
+	if shardID == core.MetachainShardId {
+		boundary, err := bp.getStartOfEpochMetaBlockBoundary(epoch)
+		if err == nil {
+			return boundary, nil
+		}
+
+		log.Debug("start of epoch metablock request, falling back to the epoch-start data", "epoch", epoch, "error", err.Error())
+	}
+
+	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(epochStartDataPath, epoch)
+	response := data.EpochStartDataApiResponse{}
+	for _, observer := range observers {
+		_, err = bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		if err != nil {
+			log.Error("epoch start data request", "observer", observer.Address, "shard ID", observer.ShardId, "error", err)
+			continue
+		}
+
+		log.Info("epoch start data request", "shard ID", observer.ShardId, "epoch", epoch, "observer", observer.Address)
+		return &data.EpochBlockBoundary{
+			Nonce:     response.Data.EpochStart.Nonce,
+			Round:     response.Data.EpochStart.Round,
+			Timestamp: response.Data.EpochStart.Timestamp,
+		}, nil
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+// getStartOfEpochMetaBlockBoundary returns the first metablock of the epoch from the start of epoch metablock, which the
+// metachain observers keep for each epoch
+func (bp *BlockProcessor) getStartOfEpochMetaBlockBoundary(epoch uint32) (*data.EpochBlockBoundary, error) {
+	response, err := bp.GetInternalStartOfEpochMetaBlock(epoch, common.Internal)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, err := json.Marshal(response.Data.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := startOfEpochMetaBlock{}
+	err = json.Unmarshal(buff, &metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.EpochBlockBoundary{
+		Nonce:     metaBlock.Nonce,
+		Round:     metaBlock.Round,
+		Timestamp: int64(metaBlock.TimeStamp),
+	}, nil
+}
+
+func (bp *BlockProcessor) getEpochBlockBoundary(shardID uint32, nonce uint64) (*data.EpochBlockBoundary, error) {
+	response, err := bp.GetBlockByNonce(shardID, nonce, common.BlockQueryOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce %d", err, nonce)
+	}
+
+	return &data.EpochBlockBoundary{
+		Nonce:     response.Data.Block.Nonce,
+		Round:     response.Data.Block.Round,
+		Timestamp: response.Data.Block.Timestamp,
+	}, nil
+}
@@ -0,0 +1,236 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// in each shard, an epoch starts at a multiple of the shard's epoch length, one round later than the previous one
+func epochStartNonce(shardID uint32, epoch uint32) uint64 {
+	if shardID == core.MetachainShardId {
+		return uint64(epoch) * 50
+	}
+
+	return uint64(epoch) * 100
+}
+
+func roundOfNonce(nonce uint64) uint64 {
+	return nonce + nonce/10
+}
+
+// epochBoundariesRequests counts the requests sent to the observers while looking up epoch boundaries
+type epochBoundariesRequests struct {
+	epochStart             uint32
+	startOfEpochMetaBlocks uint32
+	failStartOfEpochMeta   bool
+}
+
+func newEpochBoundariesObservers(t *testing.T, currentEpochs map[uint32]uint32, requests *epochBoundariesRequests) *observersStub {
+	observers := newObserversStub(t, 0, core.MetachainShardId)
+	for shardID, epoch := range currentEpochs {
+		observers.setShardMetric(shardID, process.MetricEpochNumber, uint64(epoch))
+	}
+	observers.handle("/internal/json/startofepoch/metablock/by-epoch/", func(_ uint32, path string, value interface{}) (int, error) {
+		atomic.AddUint32(&requests.startOfEpochMetaBlocks, 1)
+		if requests.failStartOfEpochMeta {
+			return 500, errors.New("start of epoch metablock not found")
+		}
+
+		epoch := uint32(0)
+		_, err := fmt.Sscanf(path, "/internal/json/startofepoch/metablock/by-epoch/%d", &epoch)
+		assert.Nil(t, err)
+
+		nonce := epochStartNonce(core.MetachainShardId, epoch)
+		response := value.(*data.InternalBlockApiResponse)
+		response.Data.Block = map[string]interface{}{
+			"nonce":     float64(nonce),
+			"round":     float64(roundOfNonce(nonce)),
+			"timeStamp": float64(roundOfNonce(nonce) * 6),
+		}
+		return 200, nil
+	})
+	observers.handle("/node/epoch-start/", func(shardID uint32, path string, value interface{}) (int, error) {
+		atomic.AddUint32(&requests.epochStart, 1)
+		epoch := uint32(0)
+		_, err := fmt.Sscanf(path, "/node/epoch-start/%d", &epoch)
+		assert.Nil(t, err)
+
+		nonce := epochStartNonce(shardID, epoch)
+		response := value.(*data.EpochStartDataApiResponse)
+		response.Data.EpochStart = data.EpochStartData{
+			Nonce:     nonce,
+			Round:     roundOfNonce(nonce),
+			Timestamp: int64(roundOfNonce(nonce) * 6),
+			Epoch:     epoch,
+			Shard:     shardID,
+		}
+		return 200, nil
+	})
+	observers.handle("/block/by-nonce/", func(_ uint32, path string, value interface{}) (int, error) {
+		nonce := uint64(0)
+		_, err := fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+		assert.Nil(t, err)
+
+		response := value.(*data.BlockApiResponse)
+		response.Data.Block = api.Block{Nonce: nonce, Round: roundOfNonce(nonce), Timestamp: int64(roundOfNonce(nonce) * 6)}
+		return 200, nil
+	})
+
+	return observers
+}
+
+func requireBoundary(t *testing.T, expectedNonce uint64, boundary *data.EpochBlockBoundary) {
+	require.NotNil(t, boundary)
+	require.Equal(t, expectedNonce, boundary.Nonce)
+	require.Equal(t, roundOfNonce(expectedNonce), boundary.Round)
+	require.Equal(t, int64(roundOfNonce(expectedNonce)*6), boundary.Timestamp)
+}
+
+func TestBlockProcessor_GetEpochBoundaries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("future epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &epochBoundariesRequests{}
+		currentEpochs := map[uint32]uint32{0: 3, core.MetachainShardId: 3}
+		bp, _ := process.NewBlockProcessor(newEpochBoundariesObservers(t, currentEpochs, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetEpochBoundaries(4)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrEpochNotStarted))
+	})
+	t.Run("past epoch should have both boundaries and be kept", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &epochBoundariesRequests{}
+		currentEpochs := map[uint32]uint32{0: 3, core.MetachainShardId: 3}
+		observers := newEpochBoundariesObservers(t, currentEpochs, requests)
+		bp, _ := process.NewBlockProcessor(observers.processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetEpochBoundaries(2)
+		require.Nil(t, err)
+		boundaries := response.Data.Epoch
+		require.Equal(t, uint32(2), boundaries.Epoch)
+		require.True(t, boundaries.IsComplete)
+		require.Len(t, boundaries.Shards, 2)
+		require.Equal(t, uint32(0), boundaries.Shards[0].ShardID)
+		requireBoundary(t, 200, boundaries.Shards[0].First)
+		requireBoundary(t, 299, boundaries.Shards[0].Last)
+		require.Equal(t, core.MetachainShardId, boundaries.Shards[1].ShardID)
+		requireBoundary(t, 100, boundaries.Shards[1].First)
+		requireBoundary(t, 149, boundaries.Shards[1].Last)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&requests.epochStart))
+		require.Equal(t, uint32(2), atomic.LoadUint32(&requests.startOfEpochMetaBlocks))
+		require.Equal(t, 2, observers.getNumNodeStatusRequests())
+
+		response, err = bp.GetEpochBoundaries(2)
+		require.Nil(t, err)
+		require.Equal(t, boundaries, response.Data.Epoch)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&requests.epochStart))
+		require.Equal(t, uint32(2), atomic.LoadUint32(&requests.startOfEpochMetaBlocks))
+		require.Equal(t, 2, observers.getNumNodeStatusRequests())
+	})
+	t.Run("genesis epoch should start at the genesis block", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &epochBoundariesRequests{}
+		currentEpochs := map[uint32]uint32{0: 3, core.MetachainShardId: 3}
+		bp, _ := process.NewBlockProcessor(newEpochBoundariesObservers(t, currentEpochs, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetEpochBoundaries(0)
+		require.Nil(t, err)
+		requireBoundary(t, 0, response.Data.Epoch.Shards[0].First)
+		requireBoundary(t, 99, response.Data.Epoch.Shards[0].Last)
+	})
+	t.Run("missing start of epoch metablock should fall back to the epoch-start data", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &epochBoundariesRequests{failStartOfEpochMeta: true}
+		currentEpochs := map[uint32]uint32{0: 3, core.MetachainShardId: 3}
+		bp, _ := process.NewBlockProcessor(newEpochBoundariesObservers(t, currentEpochs, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetEpochBoundaries(2)
+		require.Nil(t, err)
+		requireBoundary(t, 100, response.Data.Epoch.Shards[1].First)
+		requireBoundary(t, 149, response.Data.Epoch.Shards[1].Last)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&requests.startOfEpochMetaBlocks))
+		require.Equal(t, uint32(4), atomic.LoadUint32(&requests.epochStart))
+	})
+	t.Run("current epoch should not have the last block and should not be kept", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &epochBoundariesRequests{}
+		// shard 0 did not reach the epoch the metachain is in
+		currentEpochs := map[uint32]uint32{0: 2, core.MetachainShardId: 3}
+		bp, _ := process.NewBlockProcessor(newEpochBoundariesObservers(t, currentEpochs, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetEpochBoundaries(3)
+		require.Nil(t, err)
+		boundaries := response.Data.Epoch
+		require.False(t, boundaries.IsComplete)
+		require.Nil(t, boundaries.Shards[0].First)
+		require.Nil(t, boundaries.Shards[0].Last)
+		requireBoundary(t, 150, boundaries.Shards[1].First)
+		require.Nil(t, boundaries.Shards[1].Last)
+
+		response, err = bp.GetEpochBoundaries(2)
+		require.Nil(t, err)
+		require.False(t, response.Data.Epoch.IsComplete)
+		requireBoundary(t, 200, response.Data.Epoch.Shards[0].First)
+		require.Nil(t, response.Data.Epoch.Shards[0].Last)
+		requireBoundary(t, 149, response.Data.Epoch.Shards[1].Last)
+
+		numRequestsBefore := atomic.LoadUint32(&requests.epochStart)
+		_, _ = bp.GetEpochBoundaries(2)
+		require.Greater(t, atomic.LoadUint32(&requests.epochStart), numRequestsBefore)
+	})
+}
+
+func TestBlockProcessor_GetEpochsBoundaries(t *testing.T) {
+	t.Parallel()
+
+	requests := &epochBoundariesRequests{}
+	currentEpochs := map[uint32]uint32{0: 3, core.MetachainShardId: 3}
+	observers := newEpochBoundariesObservers(t, currentEpochs, requests)
+	bp, _ := process.NewBlockProcessor(observers.processor(), &mock.BlocksCacheStub{})
+
+	response, err := bp.GetEpochsBoundaries(2, 1)
+	require.Nil(t, response)
+	require.True(t, errors.Is(err, process.ErrInvalidEpochsRange))
+
+	response, err = bp.GetEpochsBoundaries(0, common.MaxEpochsRangeSize)
+	require.Nil(t, response)
+	require.True(t, errors.Is(err, process.ErrInvalidEpochsRange))
+
+	response, err = bp.GetEpochsBoundaries(3, 4)
+	require.Nil(t, response)
+	require.True(t, errors.Is(err, process.ErrEpochNotStarted))
+
+	response, err = bp.GetEpochsBoundaries(1, 3)
+	require.Nil(t, err)
+	require.Len(t, response.Data.Epochs, 3)
+	for i, boundaries := range response.Data.Epochs {
+		require.Equal(t, uint32(i+1), boundaries.Epoch)
+		requireBoundary(t, uint64(i+1)*100, boundaries.Shards[0].First)
+	}
+	require.True(t, response.Data.Epochs[1].IsComplete)
+	require.False(t, response.Data.Epochs[2].IsComplete)
+
+	// the current epoch of each shard is fetched once per request
+	numNodeStatusRequests := observers.getNumNodeStatusRequests()
+	_, err = bp.GetEpochsBoundaries(3, 3)
+	require.Nil(t, err)
+	require.Equal(t, numNodeStatusRequests+2, observers.getNumNodeStatusRequests())
+}
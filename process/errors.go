@@ -112,3 +112,112 @@ var ErrEmptyPubKey = errors.New("public key is empty")
 
 // ErrNilHttpClient signals that a nil http client has been provided
 var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilTransactionCost signals that a nil transaction cost response has been provided
+var ErrNilTransactionCost = errors.New("nil transaction cost")
+
+// ErrNilNetworkConfig signals that a nil network config has been provided
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrInvalidGasPriceModifier signals that the gas price modifier from the network config is invalid
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrInvalidNonceReservationCount signals that an invalid number of nonces to be reserved has been provided
+var ErrInvalidNonceReservationCount = errors.New("invalid nonce reservation count")
+
+// ErrInvalidBalanceHistoryRange signals that an invalid balance history range has been provided
+var ErrInvalidBalanceHistoryRange = errors.New("invalid balance history range")
+
+// ErrInvalidAccountDiffRange signals that an invalid block range has been provided for an account diff
+var ErrInvalidAccountDiffRange = errors.New("invalid account diff range")
+
+// ErrInvalidBalance signals that an invalid balance has been received
+var ErrInvalidBalance = errors.New("invalid balance")
+
+// ErrInvalidKeysStreamPageSize signals that an invalid number of keys per page has been provided for a keys stream
+var ErrInvalidKeysStreamPageSize = errors.New("invalid keys stream page size")
+
+// ErrKeysStreamRequestRejected signals that the observer rejected a keys stream request
+var ErrKeysStreamRequestRejected = errors.New("keys stream request rejected")
+
+// ErrInvalidAddressLength signals that an address with an invalid length has been provided
+var ErrInvalidAddressLength = errors.New("invalid address length")
+
+// ErrInvalidUsername signals that an invalid username has been provided
+var ErrInvalidUsername = errors.New("invalid username")
+
+// ErrUsernameNotFound signals that the provided username is not registered
+var ErrUsernameNotFound = errors.New("username not found")
+
+// ErrInvalidHyperblocksRange signals that an invalid nonce range has been provided for fetching hyperblocks
+var ErrInvalidHyperblocksRange = errors.New("invalid hyperblocks range")
+
+// ErrInvalidBlocksRange signals that an invalid nonce or round range has been provided for fetching blocks
+var ErrInvalidBlocksRange = errors.New("invalid blocks range")
+
+// ErrNilHyperblocksRangeStreamer signals that a nil hyperblocks range streamer has been provided
+var ErrNilHyperblocksRangeStreamer = errors.New("nil hyperblocks range streamer")
+
+// ErrNilLatestHyperblockNonceProvider signals that a nil latest hyperblock nonce provider has been provided
+var ErrNilLatestHyperblockNonceProvider = errors.New("nil latest hyperblock nonce provider")
+
+// ErrInvalidPollingInterval signals that an invalid polling interval has been provided
+var ErrInvalidPollingInterval = errors.New("invalid polling interval")
+
+// ErrNilBlocksCache signals that a nil blocks cache has been provided
+var ErrNilBlocksCache = errors.New("nil blocks cache")
+
+// ErrNilImmutableDataCache signals that a nil immutable data cache has been provided
+var ErrNilImmutableDataCache = errors.New("nil immutable data cache")
+
+// ErrTimestampBeforeGenesis signals that the provided timestamp is before the genesis time
+var ErrTimestampBeforeGenesis = errors.New("timestamp before genesis")
+
+// ErrNoBlockBeforeTimestamp signals that no block has been found at or before the provided timestamp
+var ErrNoBlockBeforeTimestamp = errors.New("no block found at or before timestamp")
+
+// ErrEpochNotStarted signals that the requested epoch has not started yet
+var ErrEpochNotStarted = errors.New("epoch has not started yet")
+
+// ErrInvalidEpochsRange signals that an invalid epochs range has been provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrInvalidEventsSearch signals that invalid events search criteria have been provided
+var ErrInvalidEventsSearch = errors.New("invalid events search")
+
+// ErrNilHyperblocksFollower signals that a nil hyperblocks follower has been provided
+var ErrNilHyperblocksFollower = errors.New("nil hyperblocks follower")
+
+// ErrNilWebhooksStore signals that a nil webhooks store has been provided
+var ErrNilWebhooksStore = errors.New("nil webhooks store")
+
+// ErrInvalidWebhooksStorePath signals that an invalid path has been provided for the webhooks store
+var ErrInvalidWebhooksStorePath = errors.New("invalid webhooks store path")
+
+// ErrInvalidWebhookSubscription signals that an invalid webhook subscription has been provided
+var ErrInvalidWebhookSubscription = errors.New("invalid webhook subscription")
+
+// ErrTooManyWebhookSubscriptions signals that the maximum number of webhook subscriptions has been reached
+var ErrTooManyWebhookSubscriptions = errors.New("too many webhook subscriptions")
+
+// ErrWebhookSubscriptionNotFound signals that the requested webhook subscription does not exist
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+
+// ErrWebhookDestinationNotAllowed signals that a webhook points to a loopback, link-local, private or otherwise
+// non-public address
+var ErrWebhookDestinationNotAllowed = errors.New("webhook destination not allowed")
+
+// ErrNilInternalBlocksVerifier signals that a nil internal blocks verifier has been provided
+var ErrNilInternalBlocksVerifier = errors.New("nil internal blocks verifier")
+
+// ErrInternalBlockVerification signals that a raw internal block returned by an observer failed the verification
+var ErrInternalBlockVerification = errors.New("internal block verification failed")
+
+// ErrMiniBlockNotFound signals that a miniblock could not be found in any of the searched epochs
+var ErrMiniBlockNotFound = errors.New("miniblock not found")
+
+// ErrESDTBalancesRequestAborted signals that the bulk ESDT balances request was aborted after another request failed
+var ErrESDTBalancesRequestAborted = errors.New("ESDT balances request aborted")
@@ -0,0 +1,277 @@
+package process
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	transferValueOnlyEventIdentifier = "transferValueOnly"
+	delegateEventIdentifier          = "delegate"
+	unDelegateEventIdentifier        = "unDelegate"
+	withdrawEventIdentifier          = "withdraw"
+	claimRewardsEventIdentifier      = "claimRewards"
+	reDelegateRewardsEventIdentifier = "reDelegateRewards"
+)
+
+type eventDecoderFunc func(event *transaction.Events, converter core.PubkeyConverter) (*data.DecodedEventData, bool)
+
+var eventDecoders = map[string]eventDecoderFunc{
+	core.BuiltInFunctionESDTTransfer:         decodeTokenTransferEvent,
+	core.BuiltInFunctionESDTNFTTransfer:      decodeTokenTransferEvent,
+	core.BuiltInFunctionMultiESDTNFTTransfer: decodeMultiTokenTransferEvent,
+	core.BuiltInFunctionESDTLocalMint:        decodeTokenSupplyEvent,
+	core.BuiltInFunctionESDTLocalBurn:        decodeTokenSupplyEvent,
+	core.BuiltInFunctionESDTNFTCreate:        decodeTokenSupplyEvent,
+	core.SignalErrorOperation:                decodeFailureEvent,
+	internalVMErrorsEventIdentifier:          decodeFailureEvent,
+	core.CompletedTxEventIdentifier:          decodeCompletedTxEvent,
+	core.WriteLogIdentifier:                  decodeWriteLogEvent,
+	transferValueOnlyEventIdentifier:         decodeTransferValueOnlyEvent,
+	delegateEventIdentifier:                  decodeDelegationEvent,
+	unDelegateEventIdentifier:                decodeDelegationEvent,
+	withdrawEventIdentifier:                  decodeDelegationEvent,
+	claimRewardsEventIdentifier:              decodeDelegationEvent,
+	reDelegateRewardsEventIdentifier:         decodeDelegationEvent,
+}
+
+// DecodeTransactionEvents returns the transaction along with the decoded section of the well-known events found in its
+// logs and in the logs of its smart contract results
+func (tp *TransactionProcessor) DecodeTransactionEvents(tx *transaction.ApiTransactionResult) *data.TransactionWithDecodedEvents {
+	return decodeTransactionEvents(tx, tp.pubKeyConverter)
+}
+
+// DecodeHyperblockEvents returns the hyperblock along with the decoded section of the well-known events found in the
+// logs of its transactions
+func (tp *TransactionProcessor) DecodeHyperblockEvents(hyperblock *api.Hyperblock) *data.HyperblockWithDecodedEvents {
+	transactions := make([]*data.TransactionWithDecodedEvents, 0, len(hyperblock.Transactions))
+	for _, tx := range hyperblock.Transactions {
+		transactions = append(transactions, decodeTransactionEvents(tx, tp.pubKeyConverter))
+	}
+
+	return &data.HyperblockWithDecodedEvents{
+		Hyperblock:   hyperblock,
+		Transactions: transactions,
+	}
+}
+
+func decodeTransactionEvents(tx *transaction.ApiTransactionResult, converter core.PubkeyConverter) *data.TransactionWithDecodedEvents {
+	var scrs []*data.SmartContractResultWithDecodedEvents
+	for _, scr := range tx.SmartContractResults {
+		scrs = append(scrs, &data.SmartContractResultWithDecodedEvents{
+			ApiSmartContractResult: scr,
+			Logs:                   decodeLogsEvents(scr.Logs, converter),
+		})
+	}
+
+	return &data.TransactionWithDecodedEvents{
+		ApiTransactionResult: tx,
+		SmartContractResults: scrs,
+		Logs:                 decodeLogsEvents(tx.Logs, converter),
+	}
+}
+
+func decodeLogsEvents(logs *transaction.ApiLogs, converter core.PubkeyConverter) *data.LogsWithDecodedEvents {
+	if logs == nil {
+		return nil
+	}
+
+	events := make([]*data.EventWithDecodedData, 0, len(logs.Events))
+	for _, event := range logs.Events {
+		if event == nil {
+			continue
+		}
+
+		events = append(events, &data.EventWithDecodedData{
+			Events:  event,
+			Decoded: decodeEvent(event, converter),
+		})
+	}
+
+	return &data.LogsWithDecodedEvents{
+		Address: logs.Address,
+		Events:  events,
+	}
+}
+
+// decodeEvent returns the typed fields of a well-known event, or nil if the identifier is unknown or the topics do not
+// follow the expected layout
+func decodeEvent(event *transaction.Events, converter core.PubkeyConverter) *data.DecodedEventData {
+	decoder, found := eventDecoders[event.Identifier]
+	if !found {
+		return nil
+	}
+
+	decoded, ok := decoder(event, converter)
+	if !ok {
+		return nil
+	}
+
+	return decoded
+}
+
+// decodeTokenTransferEvent handles the ESDTTransfer and ESDTNFTTransfer events: token, nonce, amount, receiver
+func decodeTokenTransferEvent(event *transaction.Events, converter core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	if len(event.Topics) != 4 {
+		return nil, false
+	}
+
+	receiver, ok := encodeAddress(event.Topics[3], converter)
+	if !ok {
+		return nil, false
+	}
+
+	return &data.DecodedEventData{
+		Token:    string(event.Topics[0]),
+		Nonce:    decodeUint64(event.Topics[1]),
+		Amount:   decodeAmount(event.Topics[2]),
+		Sender:   event.Address,
+		Receiver: receiver,
+	}, true
+}
+
+// decodeMultiTokenTransferEvent handles the MultiESDTNFTTransfer event: (token, nonce, amount) triplets and the receiver
+func decodeMultiTokenTransferEvent(event *transaction.Events, converter core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	numTopics := len(event.Topics)
+	if numTopics < 4 || (numTopics-1)%3 != 0 {
+		return nil, false
+	}
+
+	receiver, ok := encodeAddress(event.Topics[numTopics-1], converter)
+	if !ok {
+		return nil, false
+	}
+
+	transfers := make([]*data.DecodedTransfer, 0, (numTopics-1)/3)
+	for i := 0; i < numTopics-1; i += 3 {
+		transfers = append(transfers, &data.DecodedTransfer{
+			Token:  string(event.Topics[i]),
+			Nonce:  decodeUint64(event.Topics[i+1]),
+			Amount: decodeAmount(event.Topics[i+2]),
+		})
+	}
+
+	return &data.DecodedEventData{
+		Sender:    event.Address,
+		Receiver:  receiver,
+		Transfers: transfers,
+	}, true
+}
+
+// decodeTokenSupplyEvent handles the ESDTLocalMint, ESDTLocalBurn and ESDTNFTCreate events: token, nonce, amount. The
+// NFT create event carries the marshalled token attributes as fourth topic, which is not decoded
+func decodeTokenSupplyEvent(event *transaction.Events, _ core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	if len(event.Topics) < 3 {
+		return nil, false
+	}
+
+	return &data.DecodedEventData{
+		Token:  string(event.Topics[0]),
+		Nonce:  decodeUint64(event.Topics[1]),
+		Amount: decodeAmount(event.Topics[2]),
+		Sender: event.Address,
+	}, true
+}
+
+// decodeFailureEvent handles the signalError and internalVMErrors events: the caller and the error message
+func decodeFailureEvent(event *transaction.Events, converter core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	decoded := &data.DecodedEventData{
+		Message: decodeFailureEventMessage(event),
+	}
+	if len(event.Topics) > 0 {
+		decoded.Sender, _ = encodeAddress(event.Topics[0], converter)
+	}
+
+	return decoded, true
+}
+
+// decodeCompletedTxEvent handles the completedTxEvent event: the hash of the completed transaction
+func decodeCompletedTxEvent(event *transaction.Events, _ core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	if len(event.Topics) == 0 {
+		return nil, false
+	}
+
+	return &data.DecodedEventData{
+		TxHash: hex.EncodeToString(event.Topics[0]),
+	}, true
+}
+
+// decodeWriteLogEvent handles the writeLog event: the caller, the return code as message and the hex encoded return data
+func decodeWriteLogEvent(event *transaction.Events, converter core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	decoded := &data.DecodedEventData{}
+	if len(event.Topics) > 0 {
+		decoded.Sender, _ = encodeAddress(event.Topics[0], converter)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(string(event.Data), "@"), "@")
+	if len(parts[0]) > 0 {
+		returnCode, err := hex.DecodeString(parts[0])
+		if err != nil {
+			return nil, false
+		}
+		decoded.Message = string(returnCode)
+	}
+	if len(parts) > 1 {
+		decoded.ReturnData = parts[1:]
+	}
+
+	return decoded, true
+}
+
+// decodeTransferValueOnlyEvent handles the transferValueOnly event: amount and receiver
+func decodeTransferValueOnlyEvent(event *transaction.Events, converter core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	if len(event.Topics) != 2 {
+		return nil, false
+	}
+
+	receiver, ok := encodeAddress(event.Topics[1], converter)
+	if !ok {
+		return nil, false
+	}
+
+	return &data.DecodedEventData{
+		Amount:   decodeAmount(event.Topics[0]),
+		Sender:   event.Address,
+		Receiver: receiver,
+	}, true
+}
+
+// decodeDelegationEvent handles the delegate, unDelegate, withdraw, claimRewards and reDelegateRewards events of the
+// delegation contracts: the delegator and the amount, held by the first topic
+func decodeDelegationEvent(event *transaction.Events, _ core.PubkeyConverter) (*data.DecodedEventData, bool) {
+	if len(event.Topics) == 0 {
+		return nil, false
+	}
+
+	return &data.DecodedEventData{
+		Amount: decodeAmount(event.Topics[0]),
+		Sender: event.Address,
+	}, true
+}
+
+func encodeAddress(buff []byte, converter core.PubkeyConverter) (string, bool) {
+	if len(buff) != converter.Len() {
+		return "", false
+	}
+
+	address, err := converter.Encode(buff)
+	if err != nil {
+		return "", false
+	}
+
+	return address, true
+}
+
+func decodeUint64(buff []byte) uint64 {
+	return big.NewInt(0).SetBytes(buff).Uint64()
+}
+
+func decodeAmount(buff []byte) string {
+	return big.NewInt(0).SetBytes(buff).String()
+}
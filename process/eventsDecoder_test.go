@@ -0,0 +1,281 @@
+package process_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTransactionProcessorForEvents() *process.TransactionProcessor {
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		testPubkeyConverter,
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		false,
+	)
+
+	return tp
+}
+
+func decodeSingleEvent(t *testing.T, event *transaction.Events) *data.DecodedEventData {
+	tp := createTestTransactionProcessorForEvents()
+	tx := &transaction.ApiTransactionResult{
+		Logs: &transaction.ApiLogs{Events: []*transaction.Events{event}},
+	}
+
+	decodedTx := tp.DecodeTransactionEvents(tx)
+	require.Len(t, decodedTx.Logs.Events, 1)
+	require.Equal(t, event, decodedTx.Logs.Events[0].Events)
+
+	return decodedTx.Logs.Events[0].Decoded
+}
+
+func TestTransactionProcessor_DecodeTransactionEvents(t *testing.T) {
+	t.Parallel()
+
+	senderBytes := bytes.Repeat([]byte{1}, 32)
+	sender, _ := testPubkeyConverter.Encode(senderBytes)
+	receiverBytes := bytes.Repeat([]byte{2}, 32)
+	receiver, _ := testPubkeyConverter.Encode(receiverBytes)
+
+	t.Run("ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			Topics:     [][]byte{[]byte("TKN-abcdef"), {}, big.NewInt(1000).Bytes(), receiverBytes},
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Token:    "TKN-abcdef",
+			Amount:   "1000",
+			Sender:   sender,
+			Receiver: receiver,
+		}, decoded)
+	})
+	t.Run("ESDTNFTTransfer with an invalid receiver should not decode", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: core.BuiltInFunctionESDTNFTTransfer,
+			Topics:     [][]byte{[]byte("NFT-abcdef"), {5}, {1}, []byte("short")},
+		})
+		require.Nil(t, decoded)
+	})
+	t.Run("MultiESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: core.BuiltInFunctionMultiESDTNFTTransfer,
+			Topics: [][]byte{
+				[]byte("TKN-abcdef"), {}, {10},
+				[]byte("NFT-abcdef"), {7}, {1},
+				receiverBytes,
+			},
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Sender:   sender,
+			Receiver: receiver,
+			Transfers: []*data.DecodedTransfer{
+				{Token: "TKN-abcdef", Amount: "10"},
+				{Token: "NFT-abcdef", Nonce: 7, Amount: "1"},
+			},
+		}, decoded)
+	})
+	t.Run("MultiESDTNFTTransfer with incomplete transfers should not decode", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Identifier: core.BuiltInFunctionMultiESDTNFTTransfer,
+			Topics:     [][]byte{[]byte("TKN-abcdef"), {}, receiverBytes},
+		})
+		require.Nil(t, decoded)
+	})
+	t.Run("ESDTNFTCreate", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: core.BuiltInFunctionESDTNFTCreate,
+			Topics:     [][]byte{[]byte("NFT-abcdef"), {1, 0}, {1}, []byte("attributes")},
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Token:  "NFT-abcdef",
+			Nonce:  256,
+			Amount: "1",
+			Sender: sender,
+		}, decoded)
+	})
+	t.Run("signalError", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: core.SignalErrorOperation,
+			Topics:     [][]byte{senderBytes, []byte("insufficient funds")},
+			Data:       []byte("@" + hex.EncodeToString([]byte("error"))),
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Sender:  sender,
+			Message: "insufficient funds",
+		}, decoded)
+	})
+	t.Run("internalVMErrors", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Identifier: core.InternalVMErrorsOperation,
+			Topics:     [][]byte{senderBytes, []byte("add")},
+			Data:       []byte("\n\truntime.go:830 [execution failed] [add]\n"),
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Sender:  sender,
+			Message: "runtime.go:830 [execution failed] [add]",
+		}, decoded)
+	})
+	t.Run("completedTxEvent", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Identifier: core.CompletedTxEventIdentifier,
+			Topics:     [][]byte{{0xab, 0xcd}},
+		})
+		require.Equal(t, &data.DecodedEventData{TxHash: "abcd"}, decoded)
+	})
+	t.Run("writeLog", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Identifier: core.WriteLogIdentifier,
+			Topics:     [][]byte{senderBytes},
+			Data:       []byte("@6f6b@01@"),
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Sender:     sender,
+			Message:    "ok",
+			ReturnData: []string{"01", ""},
+		}, decoded)
+	})
+	t.Run("transferValueOnly", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: "transferValueOnly",
+			Topics:     [][]byte{big.NewInt(5000).Bytes(), receiverBytes},
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Amount:   "5000",
+			Sender:   sender,
+			Receiver: receiver,
+		}, decoded)
+	})
+	t.Run("delegate", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Address:    sender,
+			Identifier: "delegate",
+			Topics:     [][]byte{big.NewInt(1000).Bytes(), big.NewInt(2000).Bytes()},
+		})
+		require.Equal(t, &data.DecodedEventData{
+			Amount: "1000",
+			Sender: sender,
+		}, decoded)
+	})
+	t.Run("unknown identifier should not decode", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := decodeSingleEvent(t, &transaction.Events{
+			Identifier: "myCustomEvent",
+			Topics:     [][]byte{[]byte("topic")},
+		})
+		require.Nil(t, decoded)
+	})
+}
+
+func TestTransactionProcessor_DecodeTransactionEventsShouldKeepTransactionFields(t *testing.T) {
+	t.Parallel()
+
+	tp := createTestTransactionProcessorForEvents()
+	tx := &transaction.ApiTransactionResult{
+		Hash: "txHash",
+		SmartContractResults: []*transaction.ApiSmartContractResult{
+			{
+				Hash: "scrHash",
+				Logs: &transaction.ApiLogs{
+					Address: "scrLogs",
+					Events: []*transaction.Events{
+						{Identifier: core.CompletedTxEventIdentifier, Topics: [][]byte{{1}}},
+					},
+				},
+			},
+			{Hash: "scrWithoutLogs"},
+		},
+	}
+
+	decodedTx := tp.DecodeTransactionEvents(tx)
+	require.Nil(t, decodedTx.Logs)
+	require.Len(t, decodedTx.SmartContractResults, 2)
+	require.Nil(t, decodedTx.SmartContractResults[1].Logs)
+
+	serialized, err := json.Marshal(decodedTx)
+	require.Nil(t, err)
+
+	result := make(map[string]interface{})
+	err = json.Unmarshal(serialized, &result)
+	require.Nil(t, err)
+	require.Equal(t, "txHash", result["hash"])
+	require.NotContains(t, result, "logs")
+
+	scrs := result["smartContractResults"].([]interface{})
+	firstScr := scrs[0].(map[string]interface{})
+	require.Equal(t, "scrHash", firstScr["hash"])
+	logs := firstScr["logs"].(map[string]interface{})
+	require.Equal(t, "scrLogs", logs["address"])
+	event := logs["events"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, core.CompletedTxEventIdentifier, event["identifier"])
+	require.Equal(t, map[string]interface{}{"txHash": "01"}, event["decoded"])
+}
+
+func TestTransactionProcessor_DecodeHyperblockEvents(t *testing.T) {
+	t.Parallel()
+
+	tp := createTestTransactionProcessorForEvents()
+	hyperblock := &api.Hyperblock{
+		Nonce: 10,
+		Transactions: []*transaction.ApiTransactionResult{
+			{
+				Hash: "tx1",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{Identifier: core.CompletedTxEventIdentifier, Topics: [][]byte{{1}}},
+					},
+				},
+			},
+			{Hash: "tx2"},
+		},
+	}
+
+	decodedHyperblock := tp.DecodeHyperblockEvents(hyperblock)
+	require.Equal(t, uint64(10), decodedHyperblock.Nonce)
+	require.Len(t, decodedHyperblock.Transactions, 2)
+	require.Equal(t, "tx1", decodedHyperblock.Transactions[0].Hash)
+	require.Equal(t, &data.DecodedEventData{TxHash: "01"}, decodedHyperblock.Transactions[0].Logs.Events[0].Decoded)
+	require.Nil(t, decodedHyperblock.Transactions[1].Logs)
+}
@@ -0,0 +1,216 @@
+package process
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// errEventsSearchLimitReached stops the hyperblocks streaming of a search once enough events were found
+var errEventsSearchLimitReached = errors.New("events search limit reached")
+
+// eventsMatcher checks the events against the criteria of a search. As the topics filters can be provided as hex,
+// as text or as bech32 addresses, each of them holds all the byte sequences it can be decoded to
+type eventsMatcher struct {
+	identifier string
+	address    string
+	topics     [][][]byte
+}
+
+func newEventsMatcher(options common.EventsSearchOptions, converter core.PubkeyConverter) *eventsMatcher {
+	matcher := &eventsMatcher{
+		identifier: options.Identifier,
+		address:    options.Address,
+		topics:     make([][][]byte, 0, len(options.Topics)),
+	}
+
+	for _, topic := range options.Topics {
+		matcher.topics = append(matcher.topics, decodeTopicFilter(topic, converter))
+	}
+
+	return matcher
+}
+
+// decodeTopicFilter returns all the byte sequences the provided topic filter can be decoded to, or nil if the topic
+// position should not be filtered
+func decodeTopicFilter(topic string, converter core.PubkeyConverter) [][]byte {
+	if len(topic) == 0 {
+		return nil
+	}
+
+	candidates := [][]byte{[]byte(topic)}
+	hexDecoded, err := hex.DecodeString(topic)
+	if err == nil {
+		candidates = append(candidates, hexDecoded)
+	}
+	addressDecoded, err := converter.Decode(topic)
+	if err == nil {
+		candidates = append(candidates, addressDecoded)
+	}
+
+	return candidates
+}
+
+func (matcher *eventsMatcher) matches(event *transaction.Events) bool {
+	if len(matcher.identifier) > 0 && event.Identifier != matcher.identifier {
+		return false
+	}
+	if len(matcher.address) > 0 && event.Address != matcher.address {
+		return false
+	}
+
+	for position, candidates := range matcher.topics {
+		if candidates == nil {
+			continue
+		}
+		if position >= len(event.Topics) || !matchesAnyCandidate(event.Topics[position], candidates) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesAnyCandidate(topic []byte, candidates [][]byte) bool {
+	for _, candidate := range candidates {
+		if bytes.Equal(topic, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SearchEvents returns the events logged in the hyperblocks of the provided range which match the provided criteria,
+// along with the transactions, the shards and the blocks they originate from. The search stops once the limit is
+// reached, in which case the response holds a token to resume it from the next event
+func (bp *BlockProcessor) SearchEvents(options common.EventsSearchOptions) (*data.EventsSearchApiResponse, error) {
+	if len(options.Topics) > common.MaxEventsSearchTopics {
+		return nil, fmt.Errorf("%w: at most %d topics can be filtered", ErrInvalidEventsSearch, common.MaxEventsSearchTopics)
+	}
+	limit := options.Limit
+	if limit == 0 {
+		limit = common.DefaultEventsSearchLimit
+	}
+	if limit > common.MaxEventsSearchLimit {
+		return nil, fmt.Errorf("%w: at most %d events can be returned at once", ErrInvalidEventsSearch, common.MaxEventsSearchLimit)
+	}
+
+	converter := bp.proc.GetPubKeyConverter()
+	matcher := newEventsMatcher(options, converter)
+	foundEvents := make([]*data.FoundEvent, 0)
+	hyperblockOptions := common.HyperblockQueryOptions{
+		WithLogs: true,
+	}
+
+	var resumeState *data.EventsSearchResumeState
+	err := bp.StreamHyperBlocks(options.FromNonce, options.ToNonce, hyperblockOptions, func(hyperblock *api.Hyperblock) error {
+		events := findEvents(hyperblock, matcher, converter)
+		skipped := uint64(0)
+		if hyperblock.Nonce == options.FromNonce {
+			skipped = options.FromEventIndex
+			if skipped > uint64(len(events)) {
+				skipped = uint64(len(events))
+			}
+			events = events[skipped:]
+		}
+
+		remaining := limit - uint64(len(foundEvents))
+		if uint64(len(events)) > remaining {
+			foundEvents = append(foundEvents, events[:remaining]...)
+			resumeState = &data.EventsSearchResumeState{Nonce: hyperblock.Nonce, EventIndex: skipped + remaining}
+			return errEventsSearchLimitReached
+		}
+
+		foundEvents = append(foundEvents, events...)
+		if uint64(len(foundEvents)) == limit && hyperblock.Nonce < options.ToNonce {
+			resumeState = &data.EventsSearchResumeState{Nonce: hyperblock.Nonce + 1}
+			return errEventsSearchLimitReached
+		}
+
+		return nil
+	})
+	if err != nil && err != errEventsSearchLimitReached {
+		return nil, err
+	}
+
+	payload := data.EventsSearchApiResponsePayload{Events: foundEvents}
+	if resumeState != nil {
+		payload.ResumeToken = resumeState.ToToken()
+	}
+
+	return &data.EventsSearchApiResponse{
+		Data: payload,
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+func findEvents(hyperblock *api.Hyperblock, matcher *eventsMatcher, converter core.PubkeyConverter) []*data.FoundEvent {
+	shardBlockOfMiniBlock := make(map[string]*api.NotarizedBlock)
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		for _, miniBlockHash := range shardBlock.MiniBlockHashes {
+			shardBlockOfMiniBlock[miniBlockHash] = shardBlock
+		}
+	}
+
+	foundEvents := make([]*data.FoundEvent, 0)
+	for _, tx := range hyperblock.Transactions {
+		if tx.Logs == nil {
+			continue
+		}
+
+		for _, event := range tx.Logs.Events {
+			if event == nil || !matcher.matches(event) {
+				continue
+			}
+
+			foundEvent := &data.FoundEvent{
+				Event:           event,
+				DecodedTopics:   decodeTopics(event.Topics, converter),
+				TxHash:          tx.Hash,
+				Shard:           core.MetachainShardId,
+				BlockNonce:      hyperblock.Nonce,
+				BlockHash:       hyperblock.Hash,
+				HyperblockNonce: hyperblock.Nonce,
+				HyperblockHash:  hyperblock.Hash,
+			}
+			// the transactions executed in the metachain are not part of the notarized shard blocks
+			shardBlock, found := shardBlockOfMiniBlock[tx.MiniBlockHash]
+			if found {
+				foundEvent.Shard = shardBlock.Shard
+				foundEvent.BlockNonce = shardBlock.Nonce
+				foundEvent.BlockHash = shardBlock.Hash
+			}
+
+			foundEvents = append(foundEvents, foundEvent)
+		}
+	}
+
+	return foundEvents
+}
+
+func decodeTopics(topics [][]byte, converter core.PubkeyConverter) []*data.DecodedTopic {
+	decodedTopics := make([]*data.DecodedTopic, 0, len(topics))
+	for _, topic := range topics {
+		decodedTopic := &data.DecodedTopic{
+			Hex: hex.EncodeToString(topic),
+		}
+		if len(topic) > 0 && isPrintableText(topic) {
+			decodedTopic.String = string(topic)
+		}
+		if len(topic) == converter.Len() {
+			decodedTopic.Bech32, _ = converter.Encode(topic)
+		}
+
+		decodedTopics = append(decodedTopics, decodedTopic)
+	}
+
+	return decodedTopics
+}
@@ -0,0 +1,223 @@
+package process_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	aliceAddressBytes = bytes.Repeat([]byte{1}, 32)
+	bobAddressBytes   = bytes.Repeat([]byte{2}, 32)
+)
+
+// each hyperblock notarizes a block of shard 1, holding a token transfer from alice to bob, while the metachain
+// executes a staking call of bob
+func newEventsSearchObservers(t *testing.T) *observersStub {
+	observers := newObserversStub(t, 1, core.MetachainShardId)
+	observers.handle("/block/by-nonce/", func(_ uint32, path string, value interface{}) (int, error) {
+		nonce := uint64(0)
+		_, err := fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+		assert.Nil(t, err)
+
+		response := value.(*data.BlockApiResponse)
+		response.Data.Block = api.Block{
+			Nonce: nonce,
+			Hash:  fmt.Sprintf("meta-%d", nonce),
+			Shard: core.MetachainShardId,
+			MiniBlocks: []*api.MiniBlock{{
+				Hash:             fmt.Sprintf("meta-mb-%d", nonce),
+				SourceShard:      1,
+				DestinationShard: core.MetachainShardId,
+				Transactions: []*transaction.ApiTransactionResult{{
+					Hash:          fmt.Sprintf("stake-%d", nonce),
+					MiniBlockHash: fmt.Sprintf("meta-mb-%d", nonce),
+					Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+						{Identifier: "stake", Address: "erd1staking", Topics: [][]byte{bobAddressBytes}},
+					}},
+				}},
+			}},
+			NotarizedBlocks: []*api.NotarizedBlock{{Shard: 1, Nonce: nonce + 1000, Hash: fmt.Sprintf("shard-%d", nonce)}},
+		}
+		return 200, nil
+	})
+	observers.handle("/block/by-hash/", func(_ uint32, path string, value interface{}) (int, error) {
+		nonce := uint64(0)
+		_, err := fmt.Sscanf(path, "/block/by-hash/shard-%d", &nonce)
+		assert.Nil(t, err)
+
+		response := value.(*data.BlockApiResponse)
+		response.Data.Block = api.Block{
+			Nonce: nonce + 1000,
+			Hash:  fmt.Sprintf("shard-%d", nonce),
+			Shard: 1,
+			MiniBlocks: []*api.MiniBlock{{
+				Hash:             fmt.Sprintf("shard-mb-%d", nonce),
+				SourceShard:      1,
+				DestinationShard: 1,
+				Transactions: []*transaction.ApiTransactionResult{{
+					Hash:          fmt.Sprintf("transfer-%d", nonce),
+					MiniBlockHash: fmt.Sprintf("shard-mb-%d", nonce),
+					Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+						{Identifier: "ESDTTransfer", Address: "erd1alice", Topics: [][]byte{[]byte("MEX-abcdef"), {}, {0x05}, bobAddressBytes}},
+						{Identifier: "writeLog", Address: "erd1alice", Topics: [][]byte{aliceAddressBytes}},
+					}},
+				}},
+			}},
+		}
+		return 200, nil
+	})
+
+	return observers
+}
+
+func getFoundTxHashes(response *data.EventsSearchApiResponse) []string {
+	hashes := make([]string, 0)
+	for _, event := range response.Data.Events {
+		hashes = append(hashes, event.TxHash)
+	}
+
+	return hashes
+}
+
+func TestBlockProcessor_SearchEvents(t *testing.T) {
+	t.Parallel()
+
+	bobBech32, err := testPubkeyConverter.Encode(bobAddressBytes)
+	require.Nil(t, err)
+
+	t.Run("too many topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newEventsSearchObservers(t).processor(), &mock.BlocksCacheStub{})
+		response, err := bp.SearchEvents(common.EventsSearchOptions{FromNonce: 1, ToNonce: 2, Topics: make([]string, common.MaxEventsSearchTopics+1)})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidEventsSearch))
+	})
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newEventsSearchObservers(t).processor(), &mock.BlocksCacheStub{})
+		response, err := bp.SearchEvents(common.EventsSearchOptions{FromNonce: 2, ToNonce: 1})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidHyperblocksRange))
+	})
+	t.Run("no criteria should return all the events, with their origin", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newEventsSearchObservers(t).processor(), &mock.BlocksCacheStub{})
+		response, err := bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 6})
+		require.Nil(t, err)
+		require.Equal(t, []string{"stake-5", "transfer-5", "transfer-5", "stake-6", "transfer-6", "transfer-6"}, getFoundTxHashes(response))
+
+		stakeEvent := response.Data.Events[0]
+		require.Equal(t, core.MetachainShardId, stakeEvent.Shard)
+		require.Equal(t, uint64(5), stakeEvent.BlockNonce)
+		require.Equal(t, "meta-5", stakeEvent.BlockHash)
+
+		transferEvent := response.Data.Events[1]
+		require.Equal(t, uint32(1), transferEvent.Shard)
+		require.Equal(t, uint64(1005), transferEvent.BlockNonce)
+		require.Equal(t, "shard-5", transferEvent.BlockHash)
+		require.Equal(t, uint64(5), transferEvent.HyperblockNonce)
+		require.Equal(t, "meta-5", transferEvent.HyperblockHash)
+		require.Equal(t, []*data.DecodedTopic{
+			{Hex: hex.EncodeToString([]byte("MEX-abcdef")), String: "MEX-abcdef"},
+			{Hex: ""},
+			{Hex: "05"},
+			{Hex: hex.EncodeToString(bobAddressBytes), Bech32: bobBech32},
+		}, transferEvent.DecodedTopics)
+	})
+	t.Run("should filter by identifier and address", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newEventsSearchObservers(t).processor(), &mock.BlocksCacheStub{})
+		response, err := bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Identifier: "ESDTTransfer"})
+		require.Nil(t, err)
+		require.Len(t, response.Data.Events, 1)
+		require.Equal(t, "ESDTTransfer", response.Data.Events[0].Event.Identifier)
+
+		response, err = bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Address: "erd1staking"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"stake-5"}, getFoundTxHashes(response))
+	})
+	t.Run("should filter topics provided as text, hex or bech32", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newEventsSearchObservers(t).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Topics: []string{"MEX-abcdef"}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"transfer-5"}, getFoundTxHashes(response))
+
+		response, err = bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Topics: []string{"", "", "05"}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"transfer-5"}, getFoundTxHashes(response))
+
+		// bob is the first topic of the staking event, and the fourth one of the transfer
+		response, err = bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Topics: []string{bobBech32}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"stake-5"}, getFoundTxHashes(response))
+
+		response, err = bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Topics: []string{"", "", "", bobBech32}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"transfer-5"}, getFoundTxHashes(response))
+
+		response, err = bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 5, Topics: []string{"MEX-abcdef"}, Identifier: "writeLog"})
+		require.Nil(t, err)
+		require.Empty(t, response.Data.Events)
+	})
+	t.Run("limit should stop the search, which can be resumed", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newEventsSearchObservers(t).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.SearchEvents(common.EventsSearchOptions{FromNonce: 1, ToNonce: 2, Limit: common.MaxEventsSearchLimit + 1})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidEventsSearch))
+
+		// each hyperblock holds three events, so the search stops in the middle of the second one
+		options := common.EventsSearchOptions{FromNonce: 5, ToNonce: 7, Limit: 4}
+		response, err = bp.SearchEvents(options)
+		require.Nil(t, err)
+		require.Equal(t, []string{"stake-5", "transfer-5", "transfer-5", "stake-6"}, getFoundTxHashes(response))
+		resumeState, err := data.NewEventsSearchResumeStateFromToken(response.Data.ResumeToken)
+		require.Nil(t, err)
+		require.Equal(t, &data.EventsSearchResumeState{Nonce: 6, EventIndex: 1}, resumeState)
+
+		options.FromNonce = resumeState.Nonce
+		options.FromEventIndex = resumeState.EventIndex
+		response, err = bp.SearchEvents(options)
+		require.Nil(t, err)
+		require.Equal(t, []string{"transfer-6", "transfer-6", "stake-7", "transfer-7"}, getFoundTxHashes(response))
+		resumeState, err = data.NewEventsSearchResumeStateFromToken(response.Data.ResumeToken)
+		require.Nil(t, err)
+		require.Equal(t, &data.EventsSearchResumeState{Nonce: 7, EventIndex: 2}, resumeState)
+
+		options.FromNonce = resumeState.Nonce
+		options.FromEventIndex = resumeState.EventIndex
+		response, err = bp.SearchEvents(options)
+		require.Nil(t, err)
+		require.Equal(t, []string{"transfer-7"}, getFoundTxHashes(response))
+		require.Empty(t, response.Data.ResumeToken)
+
+		// the limit reached at the end of a hyperblock resumes from the next one
+		response, err = bp.SearchEvents(common.EventsSearchOptions{FromNonce: 5, ToNonce: 7, Limit: 3})
+		require.Nil(t, err)
+		resumeState, err = data.NewEventsSearchResumeStateFromToken(response.Data.ResumeToken)
+		require.Nil(t, err)
+		require.Equal(t, &data.EventsSearchResumeState{Nonce: 6}, resumeState)
+	})
+}
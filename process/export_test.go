@@ -39,5 +39,26 @@ func ApplySortOnScrs(tx *transaction.ApiTransactionResult) *transaction.ApiTrans
 
 // CheckIfFailed -
 func CheckIfFailed(logs []*transaction.ApiLogs) (bool, string) {
-	return checkIfFailed(logs)
+	_, event := findFailureEventInLogs(logs)
+	if event == nil {
+		return false, ""
+	}
+
+	return true, string(event.Data)
+}
+
+// ComputeDNSAddress -
+func (up *usernameProcessor) ComputeDNSAddress(dnsIndex byte) (string, error) {
+	return up.computeDNSAddress(dnsIndex)
+}
+
+// SetNonceReservationsCapacity -
+func (tp *TransactionProcessor) SetNonceReservationsCapacity(capacity int) error {
+	nonceReservations, err := newNonceReservations(capacity)
+	if err != nil {
+		return err
+	}
+
+	tp.nonceReservations = nonceReservations
+	return nil
 }
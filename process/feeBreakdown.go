@@ -0,0 +1,165 @@
+package process
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const defaultDenomination = 18
+
+type feeBreakdownArgs struct {
+	gasUsed        uint64
+	gasPrice       uint64
+	dataLength     int
+	isGuarded      bool
+	refundedValues []*big.Int
+	networkConfig  *data.NetworkConfig
+}
+
+// ComputeTransactionFeeBreakdown computes the fee breakdown of an already executed transaction, based on the
+// provided network config. The refunded amount is extracted from the refund smart contract results, if any
+func (tp *TransactionProcessor) ComputeTransactionFeeBreakdown(
+	tx *transaction.ApiTransactionResult,
+	networkConfig *data.NetworkConfig,
+) (*data.FeeBreakdown, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	gasUsed := tx.GasUsed
+	if gasUsed == 0 {
+		gasUsed = tx.GasLimit
+	}
+
+	refundedValues := make([]*big.Int, 0)
+	for _, scr := range tx.SmartContractResults {
+		if scr.IsRefund && scr.Value != nil {
+			refundedValues = append(refundedValues, scr.Value)
+		}
+	}
+
+	return computeFeeBreakdown(feeBreakdownArgs{
+		gasUsed:        gasUsed,
+		gasPrice:       tx.GasPrice,
+		dataLength:     len(tx.Data),
+		isGuarded:      len(tx.GuardianAddr) > 0,
+		refundedValues: refundedValues,
+		networkConfig:  networkConfig,
+	})
+}
+
+// ComputeTransactionCostFeeBreakdown computes the fee breakdown of a transaction cost estimation, based on the
+// provided network config
+func (tp *TransactionProcessor) ComputeTransactionCostFeeBreakdown(
+	tx *data.Transaction,
+	cost *data.TxCostResponseData,
+	networkConfig *data.NetworkConfig,
+) (*data.FeeBreakdown, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+	if cost == nil {
+		return nil, ErrNilTransactionCost
+	}
+
+	refundedValues := make([]*big.Int, 0)
+	for _, scr := range cost.ScResults {
+		if scr.ApiSmartContractResult != nil && scr.IsRefund && scr.Value != nil {
+			refundedValues = append(refundedValues, scr.Value)
+		}
+	}
+
+	return computeFeeBreakdown(feeBreakdownArgs{
+		gasUsed:        cost.TxCost,
+		gasPrice:       tx.GasPrice,
+		dataLength:     len(tx.Data),
+		isGuarded:      len(tx.GuardianAddr) > 0,
+		refundedValues: refundedValues,
+		networkConfig:  networkConfig,
+	})
+}
+
+// computeFeeBreakdown splits the fee the same way the protocol does. The economics parameters it needs (the minimum gas
+// limit, the gas per data byte, the gas price modifier and the extra gas of guarded transactions) are only exposed by
+// the network config: the gas configs hold the costs of the built-in and system smart contract functions, while the
+// economics metrics hold the supply and the accumulated fees and rewards
+func computeFeeBreakdown(args feeBreakdownArgs) (*data.FeeBreakdown, error) {
+	if args.networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	cfg := args.networkConfig.Config
+	if cfg.GasPriceModifier <= 0 || cfg.GasPriceModifier > 1 {
+		return nil, ErrInvalidGasPriceModifier
+	}
+
+	// the minimum gas price is used for cost estimations of transactions that do not specify one
+	gasPrice := args.gasPrice
+	if gasPrice == 0 {
+		gasPrice = cfg.MinGasPrice
+	}
+
+	moveBalanceGas := cfg.MinGasLimit + uint64(args.dataLength)*cfg.GasPerDataByte
+	if args.isGuarded {
+		moveBalanceGas += cfg.ExtraGasLimitGuardedTx
+	}
+
+	breakdown := &data.FeeBreakdown{
+		GasUsed: args.gasUsed,
+	}
+
+	moveBalanceFee := big.NewInt(0)
+	processingFee := big.NewInt(0)
+	if args.gasUsed <= moveBalanceGas {
+		breakdown.MoveBalanceGasUsed = args.gasUsed
+		moveBalanceFee.Mul(big.NewInt(0).SetUint64(args.gasUsed), big.NewInt(0).SetUint64(gasPrice))
+	} else {
+		breakdown.MoveBalanceGasUsed = moveBalanceGas
+		breakdown.ProcessingGasUsed = args.gasUsed - moveBalanceGas
+
+		// same computation as the protocol does: the processing part of the gas is paid with a reduced gas price
+		processingGasPrice := uint64(float64(gasPrice) * cfg.GasPriceModifier)
+		moveBalanceFee.Mul(big.NewInt(0).SetUint64(moveBalanceGas), big.NewInt(0).SetUint64(gasPrice))
+		processingFee.Mul(big.NewInt(0).SetUint64(breakdown.ProcessingGasUsed), big.NewInt(0).SetUint64(processingGasPrice))
+	}
+
+	refundedFee := big.NewInt(0)
+	for _, value := range args.refundedValues {
+		refundedFee.Add(refundedFee, value)
+	}
+
+	fee := big.NewInt(0).Add(moveBalanceFee, processingFee)
+
+	denomination := cfg.Denomination
+	if denomination <= 0 {
+		denomination = defaultDenomination
+	}
+
+	breakdown.MoveBalanceFee = moveBalanceFee.String()
+	breakdown.ProcessingFee = processingFee.String()
+	breakdown.RefundedFee = refundedFee.String()
+	breakdown.Fee = fee.String()
+	breakdown.FeeDenominated = formatDenominatedValue(fee, denomination)
+
+	return breakdown, nil
+}
+
+// formatDenominatedValue converts a value expressed in the smallest denomination into a decimal string, trimming
+// the trailing zeros of the fractional part
+func formatDenominatedValue(value *big.Int, denomination int) string {
+	digits := value.String()
+	if len(digits) <= denomination {
+		digits = strings.Repeat("0", denomination-len(digits)+1) + digits
+	}
+
+	integerPart := digits[:len(digits)-denomination]
+	fractionalPart := strings.TrimRight(digits[len(digits)-denomination:], "0")
+	if len(fractionalPart) == 0 {
+		return integerPart
+	}
+
+	return integerPart + "." + fractionalPart
+}
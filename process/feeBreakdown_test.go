@@ -0,0 +1,184 @@
+package process_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestNetworkConfigForFees() *data.NetworkConfig {
+	cfg := &data.NetworkConfig{}
+	cfg.Config.MinGasLimit = 50000
+	cfg.Config.MinGasPrice = 1000000000
+	cfg.Config.GasPerDataByte = 1500
+	cfg.Config.GasPriceModifier = 0.01
+	cfg.Config.ExtraGasLimitGuardedTx = 50000
+	cfg.Config.Denomination = 18
+
+	return cfg
+}
+
+func createTestTransactionProcessorForFees() *process.TransactionProcessor {
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		testPubkeyConverter,
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		false,
+	)
+
+	return tp
+}
+
+func TestTransactionProcessor_ComputeTransactionFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionFeeBreakdown(nil, createTestNetworkConfigForFees())
+		require.Nil(t, breakdown)
+		require.Equal(t, process.ErrNilTransaction, err)
+	})
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionFeeBreakdown(&transaction.ApiTransactionResult{}, nil)
+		require.Nil(t, breakdown)
+		require.Equal(t, process.ErrNilNetworkConfig, err)
+	})
+	t.Run("invalid gas price modifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestNetworkConfigForFees()
+		cfg.Config.GasPriceModifier = 0
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionFeeBreakdown(&transaction.ApiTransactionResult{}, cfg)
+		require.Nil(t, breakdown)
+		require.Equal(t, process.ErrInvalidGasPriceModifier, err)
+	})
+	t.Run("move balance should only have the move balance component", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			GasLimit: 50000,
+			GasUsed:  50000,
+			GasPrice: 1000000000,
+		}
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionFeeBreakdown(tx, createTestNetworkConfigForFees())
+		require.NoError(t, err)
+		require.Equal(t, &data.FeeBreakdown{
+			GasUsed:            50000,
+			MoveBalanceGasUsed: 50000,
+			ProcessingGasUsed:  0,
+			MoveBalanceFee:     "50000000000000",
+			ProcessingFee:      "0",
+			RefundedFee:        "0",
+			Fee:                "50000000000000",
+			FeeDenominated:     "0.00005",
+		}, breakdown)
+	})
+	t.Run("smart contract call with refund should split the fee", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			Sender:   "erd1sender",
+			Data:     []byte("doSomething"),
+			GasLimit: 2000000,
+			GasUsed:  1066500,
+			GasPrice: 1000000000,
+			SmartContractResults: []*transaction.ApiSmartContractResult{
+				{
+					RcvAddr:  "erd1sender",
+					Value:    big.NewInt(9335000000000),
+					IsRefund: true,
+				},
+				{
+					RcvAddr: "erd1receiver",
+					Value:   big.NewInt(1000),
+				},
+			},
+		}
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionFeeBreakdown(tx, createTestNetworkConfigForFees())
+		require.NoError(t, err)
+		require.Equal(t, &data.FeeBreakdown{
+			GasUsed:            1066500,
+			MoveBalanceGasUsed: 66500,
+			ProcessingGasUsed:  1000000,
+			MoveBalanceFee:     "66500000000000",
+			ProcessingFee:      "10000000000000",
+			RefundedFee:        "9335000000000",
+			Fee:                "76500000000000",
+			FeeDenominated:     "0.0000765",
+		}, breakdown)
+	})
+	t.Run("guarded transaction should include the extra gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.ApiTransactionResult{
+			GasUsed:      100000,
+			GasPrice:     1000000000,
+			GuardianAddr: "erd1guardian",
+		}
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionFeeBreakdown(tx, createTestNetworkConfigForFees())
+		require.NoError(t, err)
+		require.Equal(t, uint64(100000), breakdown.MoveBalanceGasUsed)
+		require.Equal(t, uint64(0), breakdown.ProcessingGasUsed)
+		require.Equal(t, "0.0001", breakdown.FeeDenominated)
+	})
+}
+
+func TestTransactionProcessor_ComputeTransactionCostFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionCostFeeBreakdown(&data.Transaction{}, nil, createTestNetworkConfigForFees())
+		require.Nil(t, breakdown)
+		require.Equal(t, process.ErrNilTransactionCost, err)
+	})
+	t.Run("missing gas price should use the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{
+			Data: []byte("doSomething"),
+		}
+		cost := &data.TxCostResponseData{
+			TxCost: 1066500,
+			ScResults: map[string]*data.ExtendedApiSmartContractResult{
+				"refund": {
+					ApiSmartContractResult: &transaction.ApiSmartContractResult{
+						Value:    big.NewInt(10),
+						IsRefund: true,
+					},
+				},
+			},
+		}
+
+		tp := createTestTransactionProcessorForFees()
+		breakdown, err := tp.ComputeTransactionCostFeeBreakdown(tx, cost, createTestNetworkConfigForFees())
+		require.NoError(t, err)
+		require.Equal(t, "66500000000000", breakdown.MoveBalanceFee)
+		require.Equal(t, "10000000000000", breakdown.ProcessingFee)
+		require.Equal(t, "10", breakdown.RefundedFee)
+		require.Equal(t, "76500000000000", breakdown.Fee)
+	})
+}
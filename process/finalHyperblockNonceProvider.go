@@ -0,0 +1,53 @@
+package process
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type finalHyperblockNonceProvider struct {
+	proc                Processor
+	latestNonceProvider LatestHyperblockNonceProvider
+}
+
+// NewFinalHyperblockNonceProvider will create a new instance of the final hyperblock nonce provider, which limits the
+// latest fully synchronized hyperblock nonce to the highest final nonce of the metachain, so the hyperblocks followed
+// with it can no longer be reverted
+func NewFinalHyperblockNonceProvider(proc Processor, latestNonceProvider LatestHyperblockNonceProvider) (*finalHyperblockNonceProvider, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(latestNonceProvider) {
+		return nil, ErrNilLatestHyperblockNonceProvider
+	}
+
+	return &finalHyperblockNonceProvider{
+		proc:                proc,
+		latestNonceProvider: latestNonceProvider,
+	}, nil
+}
+
+// GetLatestFullySynchronizedHyperblockNonce returns the nonce of the latest hyperblock that is both fully synchronized
+// across shards and final on the metachain
+func (provider *finalHyperblockNonceProvider) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+	latestNonce, err := provider.latestNonceProvider.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		return 0, err
+	}
+
+	finalNonce, err := fetchNodeStatusUintMetric(provider.proc, core.MetachainShardId, MetricHighestFinalNonce)
+	if err != nil {
+		return 0, err
+	}
+
+	if finalNonce < latestNonce {
+		return finalNonce, nil
+	}
+
+	return latestNonce, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *finalHyperblockNonceProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,109 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newFinalNonceObservers(t *testing.T, finalNonce uint64) *observersStub {
+	observers := newObserversStub(t, core.MetachainShardId)
+	observers.setMetric(process.MetricHighestFinalNonce, finalNonce)
+
+	return observers
+}
+
+func TestNewFinalHyperblockNonceProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := process.NewFinalHyperblockNonceProvider(nil, &mock.LatestHyperblockNonceProviderStub{})
+		require.True(t, check.IfNil(provider))
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil latest nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := process.NewFinalHyperblockNonceProvider(&mock.ProcessorStub{}, nil)
+		require.True(t, check.IfNil(provider))
+		require.Equal(t, process.ErrNilLatestHyperblockNonceProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := process.NewFinalHyperblockNonceProvider(&mock.ProcessorStub{}, &mock.LatestHyperblockNonceProviderStub{})
+		require.False(t, check.IfNil(provider))
+		require.Nil(t, err)
+	})
+}
+
+func TestFinalHyperblockNonceProvider_GetLatestFullySynchronizedHyperblockNonce(t *testing.T) {
+	t.Parallel()
+
+	latestNonceProvider := func(latestNonce uint64) *mock.LatestHyperblockNonceProviderStub {
+		return &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return latestNonce, nil
+			},
+		}
+	}
+
+	t.Run("latest nonce error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		provider, _ := process.NewFinalHyperblockNonceProvider(newFinalNonceObservers(t, 10).processor(), &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 0, expectedErr
+			},
+		})
+
+		_, err := provider.GetLatestFullySynchronizedHyperblockNonce()
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("final nonce error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		provider, _ := process.NewFinalHyperblockNonceProvider(&mock.ProcessorStub{
+			GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, expectedErr
+			},
+		}, latestNonceProvider(10))
+
+		_, err := provider.GetLatestFullySynchronizedHyperblockNonce()
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should return the final nonce of the metachain if lower", func(t *testing.T) {
+		t.Parallel()
+
+		proc := newFinalNonceObservers(t, 8).processor()
+		getObservers := proc.GetObserversCalled
+		proc.GetObserversCalled = func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			require.Equal(t, core.MetachainShardId, shardId)
+			return getObservers(shardId, dataAvailability)
+		}
+		provider, _ := process.NewFinalHyperblockNonceProvider(proc, latestNonceProvider(10))
+
+		nonce, err := provider.GetLatestFullySynchronizedHyperblockNonce()
+		require.Nil(t, err)
+		require.Equal(t, uint64(8), nonce)
+	})
+	t.Run("should return the latest synchronized nonce if lower", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := process.NewFinalHyperblockNonceProvider(newFinalNonceObservers(t, 12).processor(), latestNonceProvider(10))
+
+		nonce, err := provider.GetLatestFullySynchronizedHyperblockNonce()
+		require.Nil(t, err)
+		require.Equal(t, uint64(10), nonce)
+	})
+}
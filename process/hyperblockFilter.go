@@ -0,0 +1,221 @@
+package process
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// hyperblockFilter keeps only the transactions and the altered accounts of a hyperblock matching the provided filters
+type hyperblockFilter struct {
+	addresses map[string]struct{}
+	tokens    map[string]struct{}
+	functions map[string]struct{}
+	status    string
+}
+
+func newHyperblockFilter(filters common.HyperblockFilters) *hyperblockFilter {
+	return &hyperblockFilter{
+		addresses: toSet(filters.Addresses),
+		tokens:    toSet(filters.Tokens),
+		functions: toSet(filters.Functions),
+		status:    filters.Status,
+	}
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// apply returns a copy of the provided hyperblock holding only the matching transactions and altered accounts. The
+// logs of a matching transaction are kept whole, since the transaction might have matched on other criteria than its
+// events
+func (filter *hyperblockFilter) apply(hyperblock api.Hyperblock) api.Hyperblock {
+	transactions := make([]*transaction.ApiTransactionResult, 0)
+	for _, tx := range hyperblock.Transactions {
+		if filter.matchesTransaction(tx) {
+			transactions = append(transactions, tx)
+		}
+	}
+
+	shardBlocks := make([]*api.NotarizedBlock, 0, len(hyperblock.ShardBlocks))
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		filteredShardBlock := *shardBlock
+		filteredShardBlock.AlteredAccounts = filter.filterAlteredAccounts(shardBlock.AlteredAccounts)
+		shardBlocks = append(shardBlocks, &filteredShardBlock)
+	}
+
+	hyperblock.Transactions = transactions
+	hyperblock.NumTxs = uint32(len(transactions))
+	hyperblock.ShardBlocks = shardBlocks
+
+	return hyperblock
+}
+
+func (filter *hyperblockFilter) matchesTransaction(tx *transaction.ApiTransactionResult) bool {
+	if len(filter.status) > 0 && string(tx.Status) != filter.status {
+		return false
+	}
+
+	return filter.matchesAddresses(tx) && filter.matchesTokens(tx) && filter.matchesFunctions(tx)
+}
+
+func (filter *hyperblockFilter) matchesAddresses(tx *transaction.ApiTransactionResult) bool {
+	if len(filter.addresses) == 0 {
+		return true
+	}
+
+	if filter.hasAddress(tx.Sender) || filter.hasAddress(tx.Receiver) || filter.hasAddress(tx.OriginalSender) {
+		return true
+	}
+	for _, receiver := range tx.Receivers {
+		if filter.hasAddress(receiver) {
+			return true
+		}
+	}
+	if tx.Logs == nil {
+		return false
+	}
+	if filter.hasAddress(tx.Logs.Address) {
+		return true
+	}
+	for _, event := range tx.Logs.Events {
+		if event != nil && filter.hasAddress(event.Address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (filter *hyperblockFilter) hasAddress(address string) bool {
+	_, found := filter.addresses[address]
+	return found
+}
+
+func (filter *hyperblockFilter) matchesTokens(tx *transaction.ApiTransactionResult) bool {
+	if len(filter.tokens) == 0 {
+		return true
+	}
+
+	for _, token := range tx.Tokens {
+		if filter.hasToken(token) {
+			return true
+		}
+	}
+	if tx.Logs == nil {
+		return false
+	}
+	// the ESDT events hold the token identifier as topic
+	for _, event := range tx.Logs.Events {
+		if event == nil {
+			continue
+		}
+		for _, topic := range event.Topics {
+			if filter.hasToken(string(topic)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasToken returns true if the provided token, or the collection of the provided NFT, has been requested. Only the
+// NFT identifiers, holding both the random sequence and the nonce suffix, have a collection, so a ticker alone never
+// matches a fungible token
+func (filter *hyperblockFilter) hasToken(token string) bool {
+	_, found := filter.tokens[token]
+	if found {
+		return true
+	}
+
+	if strings.Count(token, "-") < 2 {
+		return false
+	}
+	lastSeparatorIndex := strings.LastIndex(token, "-")
+
+	_, found = filter.tokens[token[:lastSeparatorIndex]]
+	return found
+}
+
+func (filter *hyperblockFilter) matchesFunctions(tx *transaction.ApiTransactionResult) bool {
+	if len(filter.functions) == 0 {
+		return true
+	}
+
+	_, found := filter.functions[tx.Function]
+	if found {
+		return true
+	}
+	if tx.Logs == nil {
+		return false
+	}
+	for _, event := range tx.Logs.Events {
+		if event == nil {
+			continue
+		}
+
+		_, found = filter.functions[event.Identifier]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (filter *hyperblockFilter) filterAlteredAccounts(alteredAccounts []*alteredAccount.AlteredAccount) []*alteredAccount.AlteredAccount {
+	if len(alteredAccounts) == 0 {
+		return alteredAccounts
+	}
+
+	filteredAccounts := make([]*alteredAccount.AlteredAccount, 0)
+	for _, account := range alteredAccounts {
+		if len(filter.addresses) > 0 && !filter.hasAddress(account.Address) {
+			continue
+		}
+		if len(filter.tokens) == 0 {
+			filteredAccounts = append(filteredAccounts, account)
+			continue
+		}
+
+		tokens := make([]*alteredAccount.AccountTokenData, 0)
+		for _, token := range account.Tokens {
+			if filter.hasToken(token.Identifier) {
+				tokens = append(tokens, token)
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		filteredAccount := *account
+		filteredAccount.Tokens = tokens
+		filteredAccounts = append(filteredAccounts, &filteredAccount)
+	}
+
+	return filteredAccounts
+}
+
+func filterHyperblockResponse(response *data.HyperblockApiResponse, filters common.HyperblockFilters) *data.HyperblockApiResponse {
+	if filters.IsEmpty() {
+		return response
+	}
+
+	filter := newHyperblockFilter(filters)
+	filteredResponse := data.NewHyperblockApiResponse(filter.apply(response.Data.Hyperblock))
+	filteredResponse.Data.Complete = response.Data.Complete
+	filteredResponse.Data.MissingShardBlocks = response.Data.MissingShardBlocks
+
+	return filteredResponse
+}
@@ -0,0 +1,124 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createHyperblockToFilter() api.Hyperblock {
+	return api.Hyperblock{
+		Nonce:  42,
+		NumTxs: 5,
+		Transactions: []*transaction.ApiTransactionResult{
+			{Hash: "move-balance", Sender: "alice", Receiver: "bob", Status: transaction.TxStatusSuccess},
+			{Hash: "esdt-transfer", Sender: "carol", Receiver: "dave", Function: "ESDTTransfer", Tokens: []string{"MEX-abcdef"}, Status: transaction.TxStatusSuccess},
+			{Hash: "nft-transfer", Sender: "carol", Receiver: "carol", Function: "ESDTNFTTransfer", Receivers: []string{"erin"}, Tokens: []string{"NFT-123456-0a"}, Status: transaction.TxStatusSuccess},
+			{Hash: "sc-call", Sender: "frank", Receiver: "contract", Function: "claim", Status: transaction.TxStatusFail},
+			{Hash: "sc-call-with-logs", Sender: "frank", Receiver: "contract", Function: "claim", Status: transaction.TxStatusSuccess, Logs: &transaction.ApiLogs{
+				Address: "contract",
+				Events: []*transaction.Events{
+					{Address: "bob", Identifier: "ESDTTransfer", Topics: [][]byte{[]byte("WEGLD-bd4d79"), {}, {1}, []byte("bob")}},
+				},
+			}},
+		},
+		ShardBlocks: []*api.NotarizedBlock{
+			{Shard: 0, Nonce: 40, AlteredAccounts: []*alteredAccount.AlteredAccount{
+				{Address: "alice", Balance: "10"},
+				{Address: "bob", Balance: "20", Tokens: []*alteredAccount.AccountTokenData{{Identifier: "WEGLD-bd4d79", Balance: "1"}}},
+				{Address: "carol", Tokens: []*alteredAccount.AccountTokenData{
+					{Identifier: "MEX-abcdef", Balance: "1"},
+					{Identifier: "NFT-123456", Nonce: 10, Balance: "1"},
+				}},
+			}},
+		},
+	}
+}
+
+func getHashes(hyperblock api.Hyperblock) []string {
+	hashes := make([]string, 0)
+	for _, tx := range hyperblock.Transactions {
+		hashes = append(hashes, tx.Hash)
+	}
+
+	return hashes
+}
+
+func TestHyperblockFilter_Apply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("addresses filter should match senders, receivers and log events", func(t *testing.T) {
+		t.Parallel()
+
+		filter := newHyperblockFilter(common.HyperblockFilters{Addresses: []string{"bob", "erin"}})
+		filtered := filter.apply(createHyperblockToFilter())
+
+		require.Equal(t, []string{"move-balance", "nft-transfer", "sc-call-with-logs"}, getHashes(filtered))
+		require.Equal(t, uint32(3), filtered.NumTxs)
+		require.Len(t, filtered.ShardBlocks[0].AlteredAccounts, 1)
+		require.Equal(t, "bob", filtered.ShardBlocks[0].AlteredAccounts[0].Address)
+	})
+	t.Run("tokens filter should match tokens, collections and log topics", func(t *testing.T) {
+		t.Parallel()
+
+		filter := newHyperblockFilter(common.HyperblockFilters{Tokens: []string{"NFT-123456", "WEGLD-bd4d79"}})
+		filtered := filter.apply(createHyperblockToFilter())
+
+		require.Equal(t, []string{"nft-transfer", "sc-call-with-logs"}, getHashes(filtered))
+		alteredAccounts := filtered.ShardBlocks[0].AlteredAccounts
+		require.Len(t, alteredAccounts, 2)
+		require.Equal(t, "bob", alteredAccounts[0].Address)
+		require.Equal(t, "carol", alteredAccounts[1].Address)
+		require.Len(t, alteredAccounts[1].Tokens, 1)
+		require.Equal(t, "NFT-123456", alteredAccounts[1].Tokens[0].Identifier)
+	})
+	t.Run("ticker should not match the fungible tokens", func(t *testing.T) {
+		t.Parallel()
+
+		filter := newHyperblockFilter(common.HyperblockFilters{Tokens: []string{"MEX", "WEGLD"}})
+		filtered := filter.apply(createHyperblockToFilter())
+
+		require.Empty(t, filtered.Transactions)
+		require.Empty(t, filtered.ShardBlocks[0].AlteredAccounts)
+	})
+	t.Run("functions and status filters should be combined", func(t *testing.T) {
+		t.Parallel()
+
+		filter := newHyperblockFilter(common.HyperblockFilters{Functions: []string{"claim"}, Status: "success"})
+		filtered := filter.apply(createHyperblockToFilter())
+		require.Equal(t, []string{"sc-call-with-logs"}, getHashes(filtered))
+
+		filter = newHyperblockFilter(common.HyperblockFilters{Functions: []string{"ESDTTransfer"}})
+		filtered = filter.apply(createHyperblockToFilter())
+		require.Equal(t, []string{"esdt-transfer", "sc-call-with-logs"}, getHashes(filtered))
+		require.Len(t, filtered.ShardBlocks[0].AlteredAccounts, 3)
+	})
+	t.Run("should not alter the provided hyperblock", func(t *testing.T) {
+		t.Parallel()
+
+		hyperblock := createHyperblockToFilter()
+		filter := newHyperblockFilter(common.HyperblockFilters{Addresses: []string{"nobody"}, Tokens: []string{"MEX-abcdef"}})
+		filtered := filter.apply(hyperblock)
+
+		require.Empty(t, filtered.Transactions)
+		require.Empty(t, filtered.ShardBlocks[0].AlteredAccounts)
+		require.Equal(t, createHyperblockToFilter(), hyperblock)
+	})
+}
+
+func TestFilterHyperblockResponse(t *testing.T) {
+	t.Parallel()
+
+	response := data.NewHyperblockApiResponse(createHyperblockToFilter())
+	require.True(t, response == filterHyperblockResponse(response, common.HyperblockFilters{}))
+
+	filtered := filterHyperblockResponse(response, common.HyperblockFilters{Status: "fail"})
+	require.Equal(t, []string{"sc-call"}, getHashes(filtered.Data.Hyperblock))
+	require.Equal(t, data.ReturnCodeSuccess, filtered.Code)
+	require.Len(t, response.Data.Hyperblock.Transactions, 5)
+}
@@ -0,0 +1,342 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// hyperblocksSubscriberQueueCapacity defines how many new hyperblocks a subscriber can fall behind its feed before
+// being detached from it, in order to catch up on its own
+const hyperblocksSubscriberQueueCapacity = common.MaxHyperblocksRangeSize
+
+type hyperblocksFollower struct {
+	hyperblocksStreamer HyperblocksRangeStreamer
+	latestNonceProvider LatestHyperblockNonceProvider
+	pollingInterval     time.Duration
+
+	mutLatestNonce     sync.Mutex
+	latestNonce        uint64
+	latestNonceFetched time.Time
+
+	mutFeeds sync.Mutex
+	feeds    map[string]*hyperblocksFeed
+}
+
+// hyperblocksFeed fetches each new hyperblock once, for a set of query options, and hands it to all its subscribers
+type hyperblocksFeed struct {
+	key     string
+	options common.HyperblockQueryOptions
+
+	mutSubscribers sync.Mutex
+	subscribers    map[*hyperblocksSubscriber]struct{}
+	nextNonce      uint64
+}
+
+// hyperblocksSubscriber receives the new hyperblocks of a feed. Its queue is closed when it is detached from the feed,
+// with a nil error if it fell behind and with the fetching error otherwise
+type hyperblocksSubscriber struct {
+	fromNonce   uint64
+	hyperblocks chan *api.Hyperblock
+	err         error
+}
+
+// NewHyperblocksFollower will create a new instance of the hyperblocks follower, which hands the new hyperblocks to its
+// subscribers as soon as they are fully synchronized across shards. The latest synchronized nonce is fetched at most
+// once per polling interval and each new hyperblock is fetched once, no matter how many subscribers are following
+func NewHyperblocksFollower(
+	hyperblocksStreamer HyperblocksRangeStreamer,
+	latestNonceProvider LatestHyperblockNonceProvider,
+	pollingInterval time.Duration,
+) (*hyperblocksFollower, error) {
+	if check.IfNil(hyperblocksStreamer) {
+		return nil, ErrNilHyperblocksRangeStreamer
+	}
+	if check.IfNil(latestNonceProvider) {
+		return nil, ErrNilLatestHyperblockNonceProvider
+	}
+	if pollingInterval <= 0 {
+		return nil, ErrInvalidPollingInterval
+	}
+
+	return &hyperblocksFollower{
+		hyperblocksStreamer: hyperblocksStreamer,
+		latestNonceProvider: latestNonceProvider,
+		pollingInterval:     pollingInterval,
+		feeds:               make(map[string]*hyperblocksFeed),
+	}, nil
+}
+
+// FollowHyperBlocks hands the hyperblocks to the provided handler, in nonce order, starting from the provided nonce or,
+// if missing, from the latest fully synchronized one. The hyperblocks already synchronized are handed first, then
+// each new one as soon as it is synchronized across shards. The new hyperblocks are shared between the followers
+// using the same options, so the handler must not alter them. It returns when the context is done or on the first
+// fetching or handler error
+func (hf *hyperblocksFollower) FollowHyperBlocks(
+	ctx context.Context,
+	fromNonce core.OptionalUint64,
+	options common.HyperblockQueryOptions,
+	handler func(hyperblock *api.Hyperblock) error,
+) error {
+	nextNonce := fromNonce.Value
+	if !fromNonce.HasValue {
+		latestNonce, err := hf.getLatestNonce()
+		if err != nil {
+			return err
+		}
+
+		nextNonce = latestNonce
+	}
+
+	var err error
+	for {
+		nextNonce, err = hf.catchUp(ctx, nextNonce, options, handler)
+		if err != nil {
+			return err
+		}
+
+		feed, subscriber, joined := hf.joinFeed(options, nextNonce)
+		if !joined {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(hf.pollingInterval):
+			}
+			continue
+		}
+
+		nextNonce, err = hf.consumeFeed(ctx, feed, subscriber, options.Filters, handler)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// catchUp hands the hyperblocks up to the latest synchronized nonce and returns the next nonce to be handed
+func (hf *hyperblocksFollower) catchUp(
+	ctx context.Context,
+	nextNonce uint64,
+	options common.HyperblockQueryOptions,
+	handler func(hyperblock *api.Hyperblock) error,
+) (uint64, error) {
+	latestNonce, err := hf.getLatestNonce()
+	if err != nil {
+		log.Debug("hyperblocks follower: cannot get the latest synchronized nonce", "error", err.Error())
+		return nextNonce, nil
+	}
+
+	for nextNonce <= latestNonce {
+		toNonce := nextNonce + common.MaxHyperblocksRangeSize - 1
+		if toNonce > latestNonce {
+			toNonce = latestNonce
+		}
+
+		err = hf.hyperblocksStreamer.StreamHyperBlocks(nextNonce, toNonce, options, handler)
+		if err != nil {
+			return nextNonce, err
+		}
+		if ctx.Err() != nil {
+			return nextNonce, ctx.Err()
+		}
+
+		nextNonce = toNonce + 1
+	}
+
+	return nextNonce, nil
+}
+
+// joinFeed subscribes to the feed of the provided options, starting it if needed. It fails if the feed is already past
+// the provided nonce, in which case the hyperblocks in between have to be caught up first
+func (hf *hyperblocksFollower) joinFeed(options common.HyperblockQueryOptions, nextNonce uint64) (*hyperblocksFeed, *hyperblocksSubscriber, bool) {
+	options.Filters = common.HyperblockFilters{}
+	key := fmt.Sprintf("%+v", options)
+
+	hf.mutFeeds.Lock()
+	defer hf.mutFeeds.Unlock()
+
+	feed, found := hf.feeds[key]
+	if !found {
+		feed = &hyperblocksFeed{
+			key:         key,
+			options:     options,
+			subscribers: make(map[*hyperblocksSubscriber]struct{}),
+			nextNonce:   nextNonce,
+		}
+		hf.feeds[key] = feed
+
+		go hf.runFeed(feed)
+	}
+
+	feed.mutSubscribers.Lock()
+	defer feed.mutSubscribers.Unlock()
+
+	if feed.nextNonce > nextNonce {
+		return nil, nil, false
+	}
+
+	subscriber := &hyperblocksSubscriber{
+		fromNonce:   nextNonce,
+		hyperblocks: make(chan *api.Hyperblock, hyperblocksSubscriberQueueCapacity),
+	}
+	feed.subscribers[subscriber] = struct{}{}
+
+	return feed, subscriber, true
+}
+
+// consumeFeed hands the hyperblocks of the feed until the context is done, the handler fails or the subscriber is
+// detached from the feed. It returns the next nonce to be handed
+func (hf *hyperblocksFollower) consumeFeed(
+	ctx context.Context,
+	feed *hyperblocksFeed,
+	subscriber *hyperblocksSubscriber,
+	filters common.HyperblockFilters,
+	handler func(hyperblock *api.Hyperblock) error,
+) (uint64, error) {
+	var filter *hyperblockFilter
+	if !filters.IsEmpty() {
+		filter = newHyperblockFilter(filters)
+	}
+
+	nextNonce := subscriber.fromNonce
+	for {
+		select {
+		case <-ctx.Done():
+			feed.leave(subscriber)
+			return nextNonce, ctx.Err()
+		case hyperblock, ok := <-subscriber.hyperblocks:
+			if !ok {
+				return nextNonce, subscriber.err
+			}
+
+			if filter != nil {
+				filteredHyperblock := filter.apply(*hyperblock)
+				hyperblock = &filteredHyperblock
+			}
+
+			err := handler(hyperblock)
+			if err != nil {
+				feed.leave(subscriber)
+				return nextNonce, err
+			}
+
+			nextNonce = hyperblock.Nonce + 1
+		}
+	}
+}
+
+// runFeed fetches the new hyperblocks of the feed until it has no more subscribers or a fetch fails
+func (hf *hyperblocksFollower) runFeed(feed *hyperblocksFeed) {
+	for hf.hasSubscribers(feed) {
+		latestNonce, err := hf.getLatestNonce()
+		if err != nil {
+			log.Debug("hyperblocks follower: cannot get the latest synchronized nonce", "error", err.Error())
+		}
+
+		for err == nil && feed.nextNonce <= latestNonce {
+			toNonce := feed.nextNonce + common.MaxHyperblocksRangeSize - 1
+			if toNonce > latestNonce {
+				toNonce = latestNonce
+			}
+
+			err = hf.hyperblocksStreamer.StreamHyperBlocks(feed.nextNonce, toNonce, feed.options, feed.broadcast)
+			if err != nil {
+				hf.stopFeed(feed, err)
+				return
+			}
+		}
+
+		time.Sleep(hf.pollingInterval)
+	}
+}
+
+// hasSubscribers returns false and removes the feed if nobody follows it anymore
+func (hf *hyperblocksFollower) hasSubscribers(feed *hyperblocksFeed) bool {
+	hf.mutFeeds.Lock()
+	defer hf.mutFeeds.Unlock()
+
+	feed.mutSubscribers.Lock()
+	defer feed.mutSubscribers.Unlock()
+
+	if len(feed.subscribers) > 0 {
+		return true
+	}
+
+	delete(hf.feeds, feed.key)
+	return false
+}
+
+// stopFeed removes the feed and detaches all its subscribers with the provided error
+func (hf *hyperblocksFollower) stopFeed(feed *hyperblocksFeed, err error) {
+	hf.mutFeeds.Lock()
+	defer hf.mutFeeds.Unlock()
+
+	feed.mutSubscribers.Lock()
+	defer feed.mutSubscribers.Unlock()
+
+	delete(hf.feeds, feed.key)
+	for subscriber := range feed.subscribers {
+		subscriber.err = err
+		close(subscriber.hyperblocks)
+	}
+	feed.subscribers = make(map[*hyperblocksSubscriber]struct{})
+}
+
+// broadcast hands the hyperblock to all the subscribers of the feed. A subscriber whose queue is full is detached,
+// so that it does not hold back the others
+func (feed *hyperblocksFeed) broadcast(hyperblock *api.Hyperblock) error {
+	feed.mutSubscribers.Lock()
+	defer feed.mutSubscribers.Unlock()
+
+	for subscriber := range feed.subscribers {
+		if hyperblock.Nonce < subscriber.fromNonce {
+			continue
+		}
+
+		select {
+		case subscriber.hyperblocks <- hyperblock:
+		default:
+			delete(feed.subscribers, subscriber)
+			close(subscriber.hyperblocks)
+		}
+	}
+	feed.nextNonce = hyperblock.Nonce + 1
+
+	return nil
+}
+
+func (feed *hyperblocksFeed) leave(subscriber *hyperblocksSubscriber) {
+	feed.mutSubscribers.Lock()
+	delete(feed.subscribers, subscriber)
+	feed.mutSubscribers.Unlock()
+}
+
+func (hf *hyperblocksFollower) getLatestNonce() (uint64, error) {
+	hf.mutLatestNonce.Lock()
+	defer hf.mutLatestNonce.Unlock()
+
+	now := time.Now()
+	if now.Sub(hf.latestNonceFetched) < hf.pollingInterval {
+		return hf.latestNonce, nil
+	}
+
+	latestNonce, err := hf.latestNonceProvider.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		return 0, err
+	}
+
+	hf.latestNonce = latestNonce
+	hf.latestNonceFetched = now
+
+	return latestNonce, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hf *hyperblocksFollower) IsInterfaceNil() bool {
+	return hf == nil
+}
@@ -0,0 +1,290 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createHyperblocksRangeStreamerStub() *mock.HyperblocksRangeStreamerStub {
+	return &mock.HyperblocksRangeStreamerStub{
+		StreamHyperBlocksCalled: func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			for nonce := fromNonce; nonce <= toNonce; nonce++ {
+				err := handler(&api.Hyperblock{Nonce: nonce})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestNewHyperblocksFollower(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblocks streamer should error", func(t *testing.T) {
+		t.Parallel()
+
+		hf, err := process.NewHyperblocksFollower(nil, &mock.LatestHyperblockNonceProviderStub{}, time.Second)
+		require.Nil(t, hf)
+		require.Equal(t, process.ErrNilHyperblocksRangeStreamer, err)
+	})
+	t.Run("nil latest nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		hf, err := process.NewHyperblocksFollower(&mock.HyperblocksRangeStreamerStub{}, nil, time.Second)
+		require.Nil(t, hf)
+		require.Equal(t, process.ErrNilLatestHyperblockNonceProvider, err)
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		hf, err := process.NewHyperblocksFollower(&mock.HyperblocksRangeStreamerStub{}, &mock.LatestHyperblockNonceProviderStub{}, 0)
+		require.Nil(t, hf)
+		require.Equal(t, process.ErrInvalidPollingInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hf, err := process.NewHyperblocksFollower(&mock.HyperblocksRangeStreamerStub{}, &mock.LatestHyperblockNonceProviderStub{}, time.Second)
+		require.NoError(t, err)
+		require.False(t, hf.IsInterfaceNil())
+	})
+}
+
+func TestHyperblocksFollower_FollowHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should catch up, then hand each new hyperblock", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(250)
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return atomic.AddUint64(&latestNonce, 1) - 1, nil
+			},
+		}
+		ranges := make([][2]uint64, 0)
+		streamer := createHyperblocksRangeStreamerStub()
+		streamRange := streamer.StreamHyperBlocksCalled
+		streamer.StreamHyperBlocksCalled = func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			ranges = append(ranges, [2]uint64{fromNonce, toNonce})
+			return streamRange(fromNonce, toNonce, options, handler)
+		}
+		hf, _ := process.NewHyperblocksFollower(streamer, nonceProvider, time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		nonces := make([]uint64, 0)
+		err := hf.FollowHyperBlocks(ctx, core.OptionalUint64{Value: 10, HasValue: true}, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			nonces = append(nonces, hyperblock.Nonce)
+			if hyperblock.Nonce == 252 {
+				cancel()
+			}
+
+			return nil
+		})
+		require.Equal(t, context.Canceled, err)
+		require.Len(t, nonces, 243)
+		for i, nonce := range nonces {
+			require.Equal(t, uint64(i+10), nonce)
+		}
+		require.Equal(t, [2]uint64{10, 109}, ranges[0])
+		require.Equal(t, [2]uint64{110, 209}, ranges[1])
+		require.Equal(t, [2]uint64{210, 250}, ranges[2])
+	})
+	t.Run("missing start nonce should start from the latest one", func(t *testing.T) {
+		t.Parallel()
+
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 42, nil
+			},
+		}
+		hf, _ := process.NewHyperblocksFollower(createHyperblocksRangeStreamerStub(), nonceProvider, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		nonces := make([]uint64, 0)
+		err := hf.FollowHyperBlocks(ctx, core.OptionalUint64{}, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			nonces = append(nonces, hyperblock.Nonce)
+			cancel()
+			return nil
+		})
+		require.Equal(t, context.Canceled, err)
+		require.Equal(t, []uint64{42}, nonces)
+	})
+	t.Run("latest nonce error should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				numCalls++
+				if numCalls < 3 {
+					return 0, errors.New("observer went offline")
+				}
+
+				return 5, nil
+			},
+		}
+		hf, _ := process.NewHyperblocksFollower(createHyperblocksRangeStreamerStub(), nonceProvider, time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err := hf.FollowHyperBlocks(ctx, core.OptionalUint64{Value: 5, HasValue: true}, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			assert.Equal(t, uint64(5), hyperblock.Nonce)
+			cancel()
+			return nil
+		})
+		require.Equal(t, context.Canceled, err)
+		require.Equal(t, 3, numCalls)
+	})
+	t.Run("handler error should stop following", func(t *testing.T) {
+		t.Parallel()
+
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 100, nil
+			},
+		}
+		hf, _ := process.NewHyperblocksFollower(createHyperblocksRangeStreamerStub(), nonceProvider, time.Millisecond)
+
+		expectedErr := errors.New("client went away")
+		err := hf.FollowHyperBlocks(context.Background(), core.OptionalUint64{Value: 1, HasValue: true}, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("the latest nonce should be fetched once per polling interval for all the followers", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				atomic.AddUint32(&numCalls, 1)
+				return 7, nil
+			},
+		}
+		hf, _ := process.NewHyperblocksFollower(createHyperblocksRangeStreamerStub(), nonceProvider, time.Hour)
+
+		numFollowers := 20
+		wg := sync.WaitGroup{}
+		wg.Add(numFollowers)
+		for i := 0; i < numFollowers; i++ {
+			go func() {
+				defer wg.Done()
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				err := hf.FollowHyperBlocks(ctx, core.OptionalUint64{Value: 7, HasValue: true}, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+					cancel()
+					return nil
+				})
+				assert.Equal(t, context.Canceled, err)
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("the new hyperblocks should be fetched once for all the followers", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return atomic.LoadUint64(&latestNonce), nil
+			},
+		}
+		numFetches := uint32(0)
+		streamer := createHyperblocksRangeStreamerStub()
+		streamRange := streamer.StreamHyperBlocksCalled
+		streamer.StreamHyperBlocksCalled = func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			atomic.AddUint32(&numFetches, uint32(toNonce-fromNonce+1))
+			return streamRange(fromNonce, toNonce, options, handler)
+		}
+		hf, _ := process.NewHyperblocksFollower(streamer, nonceProvider, time.Millisecond)
+
+		numFollowers := 20
+		wg := sync.WaitGroup{}
+		wg.Add(numFollowers)
+		for i := 0; i < numFollowers; i++ {
+			go func(idx int) {
+				defer wg.Done()
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				options := common.HyperblockQueryOptions{}
+				if idx%2 == 0 {
+					options.Filters.Addresses = []string{"erd1alice"}
+				}
+				err := hf.FollowHyperBlocks(ctx, core.OptionalUint64{Value: 11, HasValue: true}, options, func(hyperblock *api.Hyperblock) error {
+					assert.Equal(t, uint64(11), hyperblock.Nonce)
+					cancel()
+					return nil
+				})
+				assert.Equal(t, context.Canceled, err)
+			}(i)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		atomic.StoreUint64(&latestNonce, 11)
+		wg.Wait()
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numFetches))
+	})
+	t.Run("fetching error should stop all the followers of a feed", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		nonceProvider := &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return atomic.LoadUint64(&latestNonce), nil
+			},
+		}
+		expectedErr := errors.New("observers went offline")
+		streamer := &mock.HyperblocksRangeStreamerStub{
+			StreamHyperBlocksCalled: func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+				return expectedErr
+			},
+		}
+		hf, _ := process.NewHyperblocksFollower(streamer, nonceProvider, time.Millisecond)
+
+		numFollowers := 5
+		wg := sync.WaitGroup{}
+		wg.Add(numFollowers)
+		for i := 0; i < numFollowers; i++ {
+			go func() {
+				defer wg.Done()
+
+				err := hf.FollowHyperBlocks(context.Background(), core.OptionalUint64{Value: 11, HasValue: true}, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+					return nil
+				})
+				assert.Equal(t, expectedErr, err)
+			}()
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		atomic.StoreUint64(&latestNonce, 11)
+		wg.Wait()
+	})
+}
@@ -0,0 +1,40 @@
+package process
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// maxConcurrentHyperblockRequests defines how many hyperblocks of a range can be fetched at the same time
+const maxConcurrentHyperblockRequests = 10
+
+// StreamHyperBlocks fetches the hyperblocks between the provided nonces (both included) concurrently and hands them,
+// in nonce order, to the provided handler. The streaming stops at the first fetching or handler error. The hyperblocks
+// are always fetched in strict mode, even if partial hyperblocks are allowed by the options, so an incomplete hyperblock
+// is never handed to the handler
+func (bp *BlockProcessor) StreamHyperBlocks(
+	fromNonce uint64,
+	toNonce uint64,
+	options common.HyperblockQueryOptions,
+	handler func(hyperblock *api.Hyperblock) error,
+) error {
+	if fromNonce > toNonce || toNonce-fromNonce >= common.MaxHyperblocksRangeSize {
+		return fmt.Errorf("%w: from %d to %d, at most %d hyperblocks allowed",
+			ErrInvalidHyperblocksRange, fromNonce, toNonce, common.MaxHyperblocksRangeSize)
+	}
+
+	options.AllowPartial = false
+
+	fetchHyperblock := func(nonce uint64) (*api.Hyperblock, error) {
+		response, err := bp.GetHyperBlockByNonce(nonce, options)
+		if err != nil {
+			return nil, fmt.Errorf("%w for nonce %d", err, nonce)
+		}
+
+		return &response.Data.Hyperblock, nil
+	}
+
+	return fetchOrdered(fromNonce, toNonce, maxConcurrentHyperblockRequests, fetchHyperblock, handler)
+}
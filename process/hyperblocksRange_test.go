@@ -0,0 +1,120 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHyperblocksRangeObservers(t *testing.T, delayForNonce func(nonce uint64) time.Duration, failingNonce uint64) *observersStub {
+	observers := newObserversStub(t, core.MetachainShardId)
+	observers.handle("/block/by-nonce/", func(_ uint32, path string, value interface{}) (int, error) {
+		nonce := uint64(0)
+		_, err := fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+		assert.Nil(t, err)
+
+		time.Sleep(delayForNonce(nonce))
+		if nonce == failingNonce {
+			return 0, errors.New("observer went offline")
+		}
+
+		response := value.(*data.BlockApiResponse)
+		response.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Hash: fmt.Sprintf("hash-%d", nonce)}}
+		return 200, nil
+	})
+
+	return observers
+}
+
+func noDelay(_ uint64) time.Duration {
+	return 0
+}
+
+func TestBlockProcessor_StreamHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newHyperblocksRangeObservers(t, noDelay, 0).processor(), &mock.BlocksCacheStub{})
+		handler := func(hyperblock *api.Hyperblock) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+
+		err := bp.StreamHyperBlocks(10, 9, common.HyperblockQueryOptions{}, handler)
+		require.True(t, errors.Is(err, process.ErrInvalidHyperblocksRange))
+
+		err = bp.StreamHyperBlocks(10, 10+common.MaxHyperblocksRangeSize, common.HyperblockQueryOptions{}, handler)
+		require.True(t, errors.Is(err, process.ErrInvalidHyperblocksRange))
+	})
+	t.Run("should hand the hyperblocks in nonce order", func(t *testing.T) {
+		t.Parallel()
+
+		// the lower the nonce, the later its hyperblock is fetched
+		delayForNonce := func(nonce uint64) time.Duration {
+			return time.Duration(30-nonce) * time.Millisecond
+		}
+		bp, _ := process.NewBlockProcessor(newHyperblocksRangeObservers(t, delayForNonce, 0).processor(), &mock.BlocksCacheStub{})
+
+		nonces := make([]uint64, 0)
+		err := bp.StreamHyperBlocks(1, 25, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			nonces = append(nonces, hyperblock.Nonce)
+			assert.Equal(t, fmt.Sprintf("hash-%d", hyperblock.Nonce), hyperblock.Hash)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, nonces, 25)
+		for i, nonce := range nonces {
+			require.Equal(t, uint64(i+1), nonce)
+		}
+	})
+	t.Run("fetching error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(newHyperblocksRangeObservers(t, noDelay, 12).processor(), &mock.BlocksCacheStub{})
+
+		nonces := make([]uint64, 0)
+		err := bp.StreamHyperBlocks(10, 20, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			nonces = append(nonces, hyperblock.Nonce)
+			return nil
+		})
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.True(t, strings.Contains(err.Error(), "for nonce 12"))
+		require.Equal(t, []uint64{10, 11}, nonces)
+	})
+	t.Run("handler error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		numFetched := uint32(0)
+		delayForNonce := func(nonce uint64) time.Duration {
+			atomic.AddUint32(&numFetched, 1)
+			return time.Millisecond
+		}
+		bp, _ := process.NewBlockProcessor(newHyperblocksRangeObservers(t, delayForNonce, 0).processor(), &mock.BlocksCacheStub{})
+
+		expectedErr := errors.New("client went away")
+		numHandled := 0
+		err := bp.StreamHyperBlocks(1, 50, common.HyperblockQueryOptions{}, func(hyperblock *api.Hyperblock) error {
+			numHandled++
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 1, numHandled)
+
+		time.Sleep(50 * time.Millisecond)
+		require.Less(t, atomic.LoadUint32(&numFetched), uint32(50))
+	})
+}
@@ -1,9 +1,11 @@
 package process
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
@@ -57,6 +59,14 @@ type GenericApiResponseCacheHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ImmutableDataCacheHandler will define what a real cacher of immutable data should do
+type ImmutableDataCacheHandler interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{})
+	Len() int
+	IsInterfaceNil() bool
+}
+
 // TransactionCostHandler will define what a real transaction cost handler should do
 type TransactionCostHandler interface {
 	ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
@@ -85,3 +95,48 @@ type StatusMetricsProvider interface {
 type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// HyperblocksRangeStreamer defines what a component able to stream a range of hyperblocks should do
+type HyperblocksRangeStreamer interface {
+	StreamHyperBlocks(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	IsInterfaceNil() bool
+}
+
+// HyperblocksFollowerHandler defines what a component able to follow the newly synchronized hyperblocks should do
+type HyperblocksFollowerHandler interface {
+	FollowHyperBlocks(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+	IsInterfaceNil() bool
+}
+
+// WebhooksStore defines what a persistent store of the webhook subscriptions should do
+type WebhooksStore interface {
+	Add(subscription *data.WebhookSubscription) error
+	Remove(id string) error
+	GetAll() []*data.WebhookSubscription
+	GetLastNonce(id string) core.OptionalUint64
+	SetLastNonce(id string, nonce uint64) error
+	IsInterfaceNil() bool
+}
+
+// LatestHyperblockNonceProvider defines what a component able to provide the latest fully synchronized hyperblock
+// nonce should do
+type LatestHyperblockNonceProvider interface {
+	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// BlocksCacheHandler defines what a cache of the blocks that can no longer change should do
+type BlocksCacheHandler interface {
+	Get(key string, value interface{}) bool
+	Put(key string, value interface{})
+	PutIfFinal(shardID uint32, nonce uint64, key string, value interface{})
+	IsInterfaceNil() bool
+}
+
+// InternalBlocksVerifier defines what a component able to verify the raw internal blocks returned by the observers
+// should do
+type InternalBlocksVerifier interface {
+	VerifyInternalBlockByHash(shardID uint32, hash string, rawBlock interface{}) error
+	VerifyInternalBlockByNonce(shardID uint32, nonce uint64, rawBlock interface{}, previousRawBlock interface{}) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,147 @@
+package process
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+type internalBlocksVerifier struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewInternalBlocksVerifier will create a new instance of the internal blocks verifier, which checks the raw headers
+// returned by the observers against the requested hash, nonce and previous header, using the configured marshalizer and
+// hasher
+func NewInternalBlocksVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*internalBlocksVerifier, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &internalBlocksVerifier{
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}, nil
+}
+
+// VerifyInternalBlockByHash checks that the provided raw header is decodable and hashes to the requested hash
+func (verifier *internalBlocksVerifier) VerifyInternalBlockByHash(shardID uint32, hash string, rawBlock interface{}) error {
+	expectedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return fmt.Errorf("%w: invalid requested hash %s", ErrInternalBlockVerification, hash)
+	}
+
+	headerBytes, err := getRawHeaderBytes(rawBlock)
+	if err != nil {
+		return err
+	}
+
+	_, err = verifier.unmarshalHeader(shardID, headerBytes)
+	if err != nil {
+		return err
+	}
+
+	computedHash := verifier.hasher.Compute(string(headerBytes))
+	if !bytes.Equal(computedHash, expectedHash) {
+		return fmt.Errorf("%w: computed hash %s does not match the requested one %s",
+			ErrInternalBlockVerification, hex.EncodeToString(computedHash), hash)
+	}
+
+	return nil
+}
+
+// VerifyInternalBlockByNonce checks that the provided raw header has the requested nonce and that its previous hash is
+// the hash of the provided previous raw header. The previous raw header is not needed for the genesis block
+func (verifier *internalBlocksVerifier) VerifyInternalBlockByNonce(shardID uint32, nonce uint64, rawBlock interface{}, previousRawBlock interface{}) error {
+	headerBytes, err := getRawHeaderBytes(rawBlock)
+	if err != nil {
+		return err
+	}
+
+	header, err := verifier.unmarshalHeader(shardID, headerBytes)
+	if err != nil {
+		return err
+	}
+
+	if header.GetNonce() != nonce {
+		return fmt.Errorf("%w: header nonce %d does not match the requested one %d",
+			ErrInternalBlockVerification, header.GetNonce(), nonce)
+	}
+	if nonce == 0 {
+		return nil
+	}
+
+	previousHeaderBytes, err := getRawHeaderBytes(previousRawBlock)
+	if err != nil {
+		return err
+	}
+
+	previousHash := verifier.hasher.Compute(string(previousHeaderBytes))
+	if !bytes.Equal(header.GetPrevHash(), previousHash) {
+		return fmt.Errorf("%w: previous hash %s does not match the hash %s of the header with nonce %d",
+			ErrInternalBlockVerification, hex.EncodeToString(header.GetPrevHash()), hex.EncodeToString(previousHash), nonce-1)
+	}
+
+	return nil
+}
+
+// unmarshalHeader decodes a metablock for the metachain, and a shard header, either v2 or v1, for the other shards
+func (verifier *internalBlocksVerifier) unmarshalHeader(shardID uint32, headerBytes []byte) (coreData.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err := verifier.marshalizer.Unmarshal(metaBlock, headerBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot unmarshal metablock: %s", ErrInternalBlockVerification, err.Error())
+		}
+
+		return metaBlock, nil
+	}
+
+	headerV2 := &block.HeaderV2{}
+	err := verifier.marshalizer.Unmarshal(headerV2, headerBytes)
+	if err == nil && headerV2.Header != nil {
+		return headerV2, nil
+	}
+
+	header := &block.Header{}
+	err = verifier.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot unmarshal shard header: %s", ErrInternalBlockVerification, err.Error())
+	}
+
+	return header, nil
+}
+
+// getRawHeaderBytes returns the bytes of a raw internal block, which is received as a base64 encoded JSON string
+func getRawHeaderBytes(rawBlock interface{}) ([]byte, error) {
+	switch value := rawBlock.(type) {
+	case []byte:
+		return value, nil
+	case string:
+		headerBytes, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid raw header encoding: %s", ErrInternalBlockVerification, err.Error())
+		}
+
+		return headerBytes, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected raw header of type %T", ErrInternalBlockVerification, rawBlock)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (verifier *internalBlocksVerifier) IsInterfaceNil() bool {
+	return verifier == nil
+}
@@ -0,0 +1,114 @@
+package process_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func marshalRawHeader(t *testing.T, header interface{}) ([]byte, string) {
+	headerBytes, err := marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	return headerBytes, hex.EncodeToString(hasher.Compute(string(headerBytes)))
+}
+
+func TestNewInternalBlocksVerifier(t *testing.T) {
+	t.Parallel()
+
+	verifier, err := process.NewInternalBlocksVerifier(nil, hasher)
+	require.True(t, check.IfNil(verifier))
+	require.Equal(t, process.ErrNilMarshalizer, err)
+
+	verifier, err = process.NewInternalBlocksVerifier(marshalizer, nil)
+	require.True(t, check.IfNil(verifier))
+	require.Equal(t, process.ErrNilHasher, err)
+
+	verifier, err = process.NewInternalBlocksVerifier(marshalizer, hasher)
+	require.False(t, check.IfNil(verifier))
+	require.Nil(t, err)
+}
+
+func TestInternalBlocksVerifier_VerifyInternalBlockByHash(t *testing.T) {
+	t.Parallel()
+
+	verifier, _ := process.NewInternalBlocksVerifier(marshalizer, hasher)
+	metaBlockBytes, metaBlockHash := marshalRawHeader(t, &block.MetaBlock{Nonce: 7, Round: 8})
+	headerV2Bytes, headerV2Hash := marshalRawHeader(t, &block.HeaderV2{Header: &block.Header{Nonce: 9, ShardID: 1}})
+	headerBytes, headerHash := marshalRawHeader(t, &block.Header{Nonce: 10, ShardID: 2})
+
+	t.Run("matching hashes should work", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, verifier.VerifyInternalBlockByHash(core.MetachainShardId, metaBlockHash, base64.StdEncoding.EncodeToString(metaBlockBytes)))
+		require.Nil(t, verifier.VerifyInternalBlockByHash(1, headerV2Hash, base64.StdEncoding.EncodeToString(headerV2Bytes)))
+		require.Nil(t, verifier.VerifyInternalBlockByHash(2, headerHash, headerBytes))
+	})
+	t.Run("mismatching hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := verifier.VerifyInternalBlockByHash(1, metaBlockHash, base64.StdEncoding.EncodeToString(headerV2Bytes))
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+	})
+	t.Run("invalid raw block should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := verifier.VerifyInternalBlockByHash(1, headerV2Hash, "not base64!")
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+
+		err = verifier.VerifyInternalBlockByHash(1, headerV2Hash, map[string]interface{}{"nonce": 9})
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+
+		err = verifier.VerifyInternalBlockByHash(core.MetachainShardId, metaBlockHash, []byte{0xff, 0xff})
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+	})
+	t.Run("invalid requested hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := verifier.VerifyInternalBlockByHash(core.MetachainShardId, "zz", metaBlockBytes)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+	})
+}
+
+func TestInternalBlocksVerifier_VerifyInternalBlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	verifier, _ := process.NewInternalBlocksVerifier(marshalizer, hasher)
+	genesisBytes, genesisHash := marshalRawHeader(t, &block.HeaderV2{Header: &block.Header{Nonce: 0, ShardID: 1}})
+	genesisHashBytes, _ := hex.DecodeString(genesisHash)
+	headerBytes, _ := marshalRawHeader(t, &block.HeaderV2{Header: &block.Header{Nonce: 1, ShardID: 1, PrevHash: genesisHashBytes}})
+
+	t.Run("genesis block should not need the previous block", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, verifier.VerifyInternalBlockByNonce(1, 0, genesisBytes, nil))
+	})
+	t.Run("linked block should work", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, verifier.VerifyInternalBlockByNonce(1, 1, headerBytes, base64.StdEncoding.EncodeToString(genesisBytes)))
+	})
+	t.Run("mismatching nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := verifier.VerifyInternalBlockByNonce(1, 2, headerBytes, genesisBytes)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+	})
+	t.Run("unlinked block should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherBytes, _ := marshalRawHeader(t, &block.HeaderV2{Header: &block.Header{Nonce: 0, ShardID: 1, Round: 5}})
+		err := verifier.VerifyInternalBlockByNonce(1, 1, headerBytes, otherBytes)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+
+		err = verifier.VerifyInternalBlockByNonce(1, 1, headerBytes, nil)
+		require.True(t, errors.Is(err, process.ErrInternalBlockVerification))
+	})
+}
@@ -0,0 +1,149 @@
+package process
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// StreamKeys iterates over all the key-value pairs of the provided address, page by page, and hands each page to the
+// provided handler. The stream is pinned to the first observer that answers and to the state root hash of the block
+// the first page was read at, so all the pages describe the same state. A stream can be resumed from the state held
+// by the resume token of any page. Returning an error from the handler stops the stream
+func (ap *AccountProcessor) StreamKeys(
+	address string,
+	numKeysPerPage uint,
+	resumeState *data.KeysStreamResumeState,
+	options common.AccountQueryOptions,
+	handler func(page *data.AccountKeysPage) error,
+) error {
+	if numKeysPerPage == 0 || numKeysPerPage > common.MaxKeysStreamPageSize {
+		return ErrInvalidKeysStreamPageSize
+	}
+
+	var iteratorState [][]byte
+	if resumeState != nil {
+		rootHash, err := hex.DecodeString(resumeState.RootHash)
+		if err != nil {
+			return data.ErrInvalidKeysStreamResumeToken
+		}
+
+		options = createRootHashPinnedAccountQueryOptions(options, rootHash)
+		iteratorState = resumeState.IteratorState
+	}
+
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	if err != nil {
+		return err
+	}
+
+	observer, response, err := ap.iterateKeysOnFirstAvailableObserver(observers, address, numKeysPerPage, iteratorState, options)
+	if err != nil {
+		return err
+	}
+
+	rootHash := response.Data.BlockInfo.RootHash
+	if resumeState != nil {
+		rootHash = resumeState.RootHash
+	}
+	pinnedRootHash, err := hex.DecodeString(rootHash)
+	if err != nil || len(pinnedRootHash) == 0 {
+		return fmt.Errorf("%w: the observer did not provide the state root hash", ErrSendingRequest)
+	}
+	options = createRootHashPinnedAccountQueryOptions(options, pinnedRootHash)
+
+	numPages := 0
+	for {
+		numPages++
+		page := &data.AccountKeysPage{
+			Pairs:     response.Data.Pairs,
+			BlockInfo: response.Data.BlockInfo,
+		}
+		iteratorState = response.Data.NewIteratorState
+		if len(iteratorState) > 0 {
+			resumeToken := &data.KeysStreamResumeState{
+				RootHash:      rootHash,
+				IteratorState: iteratorState,
+			}
+			page.ResumeToken = resumeToken.ToToken()
+		}
+
+		err = handler(page)
+		if err != nil {
+			return err
+		}
+		if len(iteratorState) == 0 {
+			log.Info("keys stream", "address", address, "observer", observer.Address, "num pages", numPages)
+			return nil
+		}
+
+		response, err = ap.iterateKeysOnObserver(observer, address, numKeysPerPage, iteratorState, options)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (ap *AccountProcessor) iterateKeysOnFirstAvailableObserver(
+	observers []*data.NodeData,
+	address string,
+	numKeys uint,
+	iteratorState [][]byte,
+	options common.AccountQueryOptions,
+) (*data.NodeData, *data.IterateKeysApiResponse, error) {
+	lastErrMessage := ""
+	for _, observer := range observers {
+		response, err := ap.iterateKeysOnObserver(observer, address, numKeys, iteratorState, options)
+		if err == nil {
+			return observer, response, nil
+		}
+		if errors.Is(err, ErrKeysStreamRequestRejected) {
+			return nil, nil, err
+		}
+
+		lastErrMessage = err.Error()
+		log.Error("keys stream", "observer", observer.Address, "address", address, "error", lastErrMessage)
+	}
+
+	return nil, nil, WrapObserversError(lastErrMessage)
+}
+
+func (ap *AccountProcessor) iterateKeysOnObserver(
+	observer *data.NodeData,
+	address string,
+	numKeys uint,
+	iteratorState [][]byte,
+	options common.AccountQueryOptions,
+) (*data.IterateKeysApiResponse, error) {
+	iterateKeysReq := data.IterateKeysRequest{
+		Address:       address,
+		NumKeys:       numKeys,
+		IteratorState: iteratorState,
+	}
+
+	response := &data.IterateKeysApiResponse{}
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+"iterate-keys", options)
+	respCode, err := ap.proc.CallPostRestEndPoint(observer.Address, apiPath, iterateKeysReq, response)
+	if respCode == http.StatusBadRequest && response.Error != "" {
+		return nil, fmt.Errorf("%w: %s", ErrKeysStreamRequestRejected, response.Error)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// createRootHashPinnedAccountQueryOptions returns the options that point to the state with the provided root hash
+func createRootHashPinnedAccountQueryOptions(options common.AccountQueryOptions, rootHash []byte) common.AccountQueryOptions {
+	return common.AccountQueryOptions{
+		BlockRootHash: rootHash,
+		HintEpoch:     options.HintEpoch,
+		ForcedShardID: options.ForcedShardID,
+	}
+}
@@ -0,0 +1,186 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const keysStreamRootHash = "aabbcc"
+
+// createAccountProcessorForKeysStream returns an account processor whose observers hold numKeys keys, answered in
+// pages, where the iterator state is the index of the next key to be returned
+func createAccountProcessorForKeysStream(numKeys int, calledPaths *[]string, mut *sync.Mutex) *process.AccountProcessor {
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "offline observer", ShardId: shardId},
+					{Address: "observer", ShardId: shardId},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, request interface{}, value interface{}) (int, error) {
+				if address == "offline observer" {
+					return http.StatusNotFound, errors.New("offline")
+				}
+
+				mut.Lock()
+				*calledPaths = append(*calledPaths, path)
+				mut.Unlock()
+
+				iterateKeysReq := request.(data.IterateKeysRequest)
+				start := 0
+				if len(iterateKeysReq.IteratorState) > 0 {
+					_, _ = fmt.Sscanf(string(iterateKeysReq.IteratorState[0]), "%d", &start)
+				}
+
+				response := value.(*data.IterateKeysApiResponse)
+				response.Data.Pairs = make(map[string]string)
+				response.Data.BlockInfo = data.BlockInfo{Nonce: 7, RootHash: keysStreamRootHash}
+				end := start + int(iterateKeysReq.NumKeys)
+				if end > numKeys {
+					end = numKeys
+				}
+				for i := start; i < end; i++ {
+					response.Data.Pairs[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
+				}
+				if end < numKeys {
+					response.Data.NewIteratorState = [][]byte{[]byte(fmt.Sprintf("%d", end))}
+				}
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+	)
+
+	return ap
+}
+
+func TestAccountProcessor_StreamKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+
+		err := ap.StreamKeys("DEADBEEF", 0, nil, common.AccountQueryOptions{}, nil)
+		require.Equal(t, process.ErrInvalidKeysStreamPageSize, err)
+
+		err = ap.StreamKeys("DEADBEEF", common.MaxKeysStreamPageSize+1, nil, common.AccountQueryOptions{}, nil)
+		require.Equal(t, process.ErrInvalidKeysStreamPageSize, err)
+	})
+	t.Run("observer rejecting the request should error without trying the others", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer0"}, {Address: "observer1"}}, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, request interface{}, value interface{}) (int, error) {
+					numCalls++
+					value.(*data.IterateKeysApiResponse).Error = "invalid iterator state"
+					return http.StatusBadRequest, errors.New("bad request")
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		resumeState := &data.KeysStreamResumeState{RootHash: keysStreamRootHash, IteratorState: [][]byte{[]byte("state")}}
+		err := ap.StreamKeys("DEADBEEF", 10, resumeState, common.AccountQueryOptions{}, func(page *data.AccountKeysPage) error {
+			return nil
+		})
+		require.True(t, errors.Is(err, process.ErrKeysStreamRequestRejected))
+		require.True(t, strings.Contains(err.Error(), "invalid iterator state"))
+		require.Equal(t, 1, numCalls)
+	})
+	t.Run("handler error should stop the stream", func(t *testing.T) {
+		t.Parallel()
+
+		calledPaths := make([]string, 0)
+		ap := createAccountProcessorForKeysStream(25, &calledPaths, &sync.Mutex{})
+
+		expectedErr := errors.New("client gone")
+		err := ap.StreamKeys("DEADBEEF", 10, nil, common.AccountQueryOptions{}, func(page *data.AccountKeysPage) error {
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Len(t, calledPaths, 1)
+	})
+	t.Run("should stream all the pages pinned to the same root hash", func(t *testing.T) {
+		t.Parallel()
+
+		mut := sync.Mutex{}
+		calledPaths := make([]string, 0)
+		ap := createAccountProcessorForKeysStream(25, &calledPaths, &mut)
+
+		pairs := make(map[string]string)
+		resumeTokens := make([]string, 0)
+		err := ap.StreamKeys("DEADBEEF", 10, nil, common.AccountQueryOptions{OnFinalBlock: true}, func(page *data.AccountKeysPage) error {
+			for key, value := range page.Pairs {
+				pairs[key] = value
+			}
+			resumeTokens = append(resumeTokens, page.ResumeToken)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, pairs, 25)
+		require.Equal(t, "value24", pairs["key24"])
+		require.Len(t, resumeTokens, 3)
+		require.Empty(t, resumeTokens[2])
+
+		pinnedPath := "/address/iterate-keys?blockRootHash=" + keysStreamRootHash
+		require.Equal(t, []string{"/address/iterate-keys?onFinalBlock=true", pinnedPath, pinnedPath}, calledPaths)
+
+		resumeState, err := data.NewKeysStreamResumeStateFromToken(resumeTokens[1])
+		require.Nil(t, err)
+		require.Equal(t, keysStreamRootHash, resumeState.RootHash)
+		require.Equal(t, [][]byte{[]byte("20")}, resumeState.IteratorState)
+	})
+	t.Run("should resume from the token", func(t *testing.T) {
+		t.Parallel()
+
+		mut := sync.Mutex{}
+		calledPaths := make([]string, 0)
+		ap := createAccountProcessorForKeysStream(25, &calledPaths, &mut)
+
+		resumeState := &data.KeysStreamResumeState{RootHash: keysStreamRootHash, IteratorState: [][]byte{[]byte("20")}}
+		pairs := make(map[string]string)
+		err := ap.StreamKeys("DEADBEEF", 10, resumeState, common.AccountQueryOptions{}, func(page *data.AccountKeysPage) error {
+			for key, value := range page.Pairs {
+				pairs[key] = value
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, pairs, 5)
+		require.Equal(t, []string{"/address/iterate-keys?blockRootHash=" + keysStreamRootHash}, calledPaths)
+	})
+	t.Run("invalid root hash in resume state should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+
+		resumeState := &data.KeysStreamResumeState{RootHash: "not hex", IteratorState: [][]byte{[]byte("state")}}
+		err := ap.StreamKeys("DEADBEEF", 10, resumeState, common.AccountQueryOptions{}, nil)
+		require.Equal(t, data.ErrInvalidKeysStreamResumeToken, err)
+	})
+}
@@ -0,0 +1,153 @@
+package process
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// maxMiniBlockEpochsSearched defines how many epochs, back from the current one, are searched for a miniblock
+	maxMiniBlockEpochsSearched = 30
+	// maxConcurrentMiniBlockEpochRequests defines how many epochs are searched at the same time for a miniblock
+	maxConcurrentMiniBlockEpochRequests = 5
+	// miniBlockMissesCacheCapacity defines how many miniblocks not found by the search are remembered
+	miniBlockMissesCacheCapacity = 10000
+	// miniBlockMissesCacheValidity defines for how long a miniblock not found by the search is not searched again, as
+	// it might be included in a block meanwhile
+	miniBlockMissesCacheValidity = time.Minute
+)
+
+type miniBlockEpochResult struct {
+	epoch    uint32
+	response *data.InternalMiniBlockApiResponse
+	err      error
+}
+
+// GetInternalMiniBlockByHashWithoutEpoch returns the miniblock based on its hash, finding the epoch it is stored in.
+// The epochs given by the hints are tried first, then the recent epochs are searched backwards, a few at a time. The
+// found epoch is kept in cache, as it never changes, while a miniblock not found is not searched again for a while
+func (bp *BlockProcessor) GetInternalMiniBlockByHashWithoutEpoch(
+	shardID uint32,
+	hash string,
+	hints common.MiniBlockEpochHints,
+	format common.OutputFormat,
+) (*data.InternalMiniBlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("miniblock_epoch_%d_%s", shardID, hash)
+	epoch := uint32(0)
+	if bp.blocksCache.Get(cacheKey, &epoch) {
+		return bp.GetInternalMiniBlockByHash(shardID, hash, epoch, format)
+	}
+
+	missKey := fmt.Sprintf("%d_%s_%s_%s", shardID, hash, hints.TxHash, hints.BlockHash)
+	searchedEpochs, isKnownMiss := bp.miniBlockMissesCache.Get(missKey)
+	if isKnownMiss {
+		return nil, fmt.Errorf("%w: hash %s, searched epochs %v", ErrMiniBlockNotFound, hash, searchedEpochs)
+	}
+
+	candidateEpochs := bp.getMiniBlockHintedEpochs(shardID, hash, hints)
+	currentEpoch, err := fetchNodeStatusUintMetric(bp.proc, shardID, MetricEpochNumber)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < maxMiniBlockEpochsSearched && i <= currentEpoch; i++ {
+		candidateEpochs = appendEpochIfMissing(candidateEpochs, uint32(currentEpoch-i))
+	}
+
+	for start := 0; start < len(candidateEpochs); start += maxConcurrentMiniBlockEpochRequests {
+		end := start + maxConcurrentMiniBlockEpochRequests
+		if end > len(candidateEpochs) {
+			end = len(candidateEpochs)
+		}
+
+		result := bp.searchMiniBlockInEpochs(shardID, hash, candidateEpochs[start:end], format)
+		if result != nil {
+			bp.blocksCache.Put(cacheKey, result.epoch)
+			return result.response, nil
+		}
+	}
+
+	log.Debug("miniblock not found", "shard id", shardID, "hash", hash, "searched epochs", candidateEpochs)
+	bp.miniBlockMissesCache.Put(missKey, candidateEpochs)
+
+	return nil, fmt.Errorf("%w: hash %s, searched epochs %v", ErrMiniBlockNotFound, hash, candidateEpochs)
+}
+
+// getMiniBlockHintedEpochs returns the epoch of the provided transaction, if it belongs to the miniblock, and the epoch
+// of the provided block. The hints which cannot be fetched are ignored
+func (bp *BlockProcessor) getMiniBlockHintedEpochs(shardID uint32, hash string, hints common.MiniBlockEpochHints) []uint32 {
+	epochs := make([]uint32, 0)
+	if len(hints.TxHash) > 0 {
+		tx, err := bp.getTransactionForHint(shardID, hints.TxHash)
+		if err != nil {
+			log.Debug("miniblock epoch hint", "tx hash", hints.TxHash, "error", err.Error())
+		}
+		if err == nil && tx.MiniBlockHash == hash {
+			epochs = appendEpochIfMissing(epochs, tx.Epoch)
+		}
+	}
+	if len(hints.BlockHash) > 0 {
+		blockResponse, err := bp.GetBlockByHash(shardID, hints.BlockHash, common.BlockQueryOptions{})
+		if err != nil {
+			log.Debug("miniblock epoch hint", "block hash", hints.BlockHash, "error", err.Error())
+		}
+		if err == nil {
+			epochs = appendEpochIfMissing(epochs, blockResponse.Data.Block.Epoch)
+		}
+	}
+
+	return epochs
+}
+
+func (bp *BlockProcessor) getTransactionForHint(shardID uint32, txHash string) (*transaction.ApiTransactionResult, error) {
+	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := data.GetTransactionResponse{}
+	for _, observer := range observers {
+		_, err = bp.proc.CallGetRestEndPoint(observer.Address, TransactionPath+txHash, &response)
+		if err == nil {
+			return &response.Data.Transaction, nil
+		}
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+// searchMiniBlockInEpochs requests the miniblock in all the provided epochs at the same time and returns the result of
+// the first epoch, in the provided order, holding it
+func (bp *BlockProcessor) searchMiniBlockInEpochs(shardID uint32, hash string, epochs []uint32, format common.OutputFormat) *miniBlockEpochResult {
+	results := make([]chan *miniBlockEpochResult, len(epochs))
+	for i, epoch := range epochs {
+		results[i] = make(chan *miniBlockEpochResult, 1)
+		go func(epoch uint32, result chan<- *miniBlockEpochResult) {
+			response, err := bp.getInternalMiniBlockByHash(shardID, hash, epoch, format, log.Debug)
+			result <- &miniBlockEpochResult{epoch: epoch, response: response, err: err}
+		}(epoch, results[i])
+	}
+
+	var found *miniBlockEpochResult
+	for _, resultChan := range results {
+		result := <-resultChan
+		if found == nil && result.err == nil {
+			found = result
+		}
+	}
+
+	return found
+}
+
+func appendEpochIfMissing(epochs []uint32, epoch uint32) []uint32 {
+	for _, existingEpoch := range epochs {
+		if existingEpoch == epoch {
+			return epochs
+		}
+	}
+
+	return append(epochs, epoch)
+}
@@ -0,0 +1,194 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMiniBlockHash = "aaaa"
+	testTxHash        = "bbbb"
+	testBlockHash     = "cccc"
+)
+
+type miniBlockEpochRequests struct {
+	mut    sync.Mutex
+	epochs []uint32
+}
+
+func (requests *miniBlockEpochRequests) add(epoch uint32) {
+	requests.mut.Lock()
+	requests.epochs = append(requests.epochs, epoch)
+	requests.mut.Unlock()
+}
+
+func (requests *miniBlockEpochRequests) has(epoch uint32) bool {
+	requests.mut.Lock()
+	defer requests.mut.Unlock()
+
+	for _, requestedEpoch := range requests.epochs {
+		if requestedEpoch == epoch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newMiniBlockEpochObservers(
+	t *testing.T,
+	currentEpoch uint32,
+	miniBlockEpoch uint32,
+	txEpoch uint32,
+	blockEpoch uint32,
+	requests *miniBlockEpochRequests,
+) *observersStub {
+	observers := newObserversStub(t, 0)
+	observers.setMetric(process.MetricEpochNumber, uint64(currentEpoch))
+	observers.handle("/internal/json/miniblock/by-hash/", func(_ uint32, path string, value interface{}) (int, error) {
+		epoch := uint32(0)
+		_, err := fmt.Sscanf(path, "/internal/json/miniblock/by-hash/"+testMiniBlockHash+"/epoch/%d", &epoch)
+		assert.Nil(t, err)
+
+		requests.add(epoch)
+		if epoch != miniBlockEpoch {
+			return 404, errors.New("miniblock not found")
+		}
+		response := value.(*data.InternalMiniBlockApiResponse)
+		response.Data.MiniBlock = map[string]interface{}{"epoch": float64(epoch)}
+		return 200, nil
+	})
+	observers.handle(process.TransactionPath+testTxHash, func(_ uint32, _ string, value interface{}) (int, error) {
+		response := value.(*data.GetTransactionResponse)
+		response.Data.Transaction = transaction.ApiTransactionResult{MiniBlockHash: testMiniBlockHash, Epoch: txEpoch}
+		return 200, nil
+	})
+	observers.handle("/block/by-hash/"+testBlockHash, func(_ uint32, _ string, value interface{}) (int, error) {
+		response := value.(*data.BlockApiResponse)
+		response.Data.Block = api.Block{Hash: testBlockHash, Epoch: blockEpoch}
+		return 200, nil
+	})
+
+	return observers
+}
+
+func TestBlockProcessor_GetInternalMiniBlockByHashWithoutEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hinted epoch should be tried first", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 100, 40, 40, 3, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{TxHash: testTxHash}, common.Internal)
+		require.Nil(t, err)
+		require.Equal(t, map[string]interface{}{"epoch": float64(40)}, response.Data.MiniBlock)
+		require.True(t, requests.has(40))
+		require.True(t, requests.has(100))
+		require.False(t, requests.has(96), "the first batch should hold the hinted epoch and the most recent ones")
+	})
+	t.Run("block hint should be used", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 100, 3, 0, 3, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{BlockHash: testBlockHash}, common.Internal)
+		require.Nil(t, err)
+		require.Equal(t, map[string]interface{}{"epoch": float64(3)}, response.Data.MiniBlock)
+	})
+	t.Run("recent epochs should be searched backwards", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 10, 2, 0, 0, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{}, common.Internal)
+		require.Nil(t, err)
+		require.Equal(t, map[string]interface{}{"epoch": float64(2)}, response.Data.MiniBlock)
+		require.True(t, requests.has(10))
+		require.True(t, requests.has(6))
+		require.False(t, requests.has(0), "the search should stop at the batch holding the miniblock")
+	})
+	t.Run("found epoch should be cached", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		cachedValues := make(map[string]interface{})
+		cacheStub := &mock.BlocksCacheStub{
+			PutCalled: func(key string, value interface{}) {
+				cachedValues[key] = value
+			},
+		}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 10, 7, 0, 0, requests).processor(), cacheStub)
+
+		_, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{}, common.Internal)
+		require.Nil(t, err)
+		require.Equal(t, uint32(7), cachedValues["miniblock_epoch_0_"+testMiniBlockHash])
+	})
+	t.Run("cached epoch should be used", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		cacheStub := &mock.BlocksCacheStub{
+			GetCalled: func(key string, value interface{}) bool {
+				if key != "miniblock_epoch_0_"+testMiniBlockHash {
+					return false
+				}
+				*value.(*uint32) = 7
+				return true
+			},
+		}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 10, 7, 0, 0, requests).processor(), cacheStub)
+
+		response, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{}, common.Internal)
+		require.Nil(t, err)
+		require.Equal(t, map[string]interface{}{"epoch": float64(7)}, response.Data.MiniBlock)
+		require.Equal(t, []uint32{7}, requests.epochs)
+	})
+	t.Run("miniblock not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 50, 5, 0, 0, requests).processor(), &mock.BlocksCacheStub{})
+
+		response, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{}, common.Internal)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrMiniBlockNotFound))
+		require.True(t, requests.has(21))
+		require.False(t, requests.has(20), "only the most recent epochs should be searched")
+	})
+	t.Run("miniblock not found should not be searched again for a while", func(t *testing.T) {
+		t.Parallel()
+
+		requests := &miniBlockEpochRequests{}
+		bp, _ := process.NewBlockProcessor(newMiniBlockEpochObservers(t, 50, 5, 0, 0, requests).processor(), &mock.BlocksCacheStub{})
+
+		_, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{}, common.Internal)
+		require.True(t, errors.Is(err, process.ErrMiniBlockNotFound))
+		numRequests := len(requests.epochs)
+
+		response, err := bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{}, common.Internal)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrMiniBlockNotFound))
+		require.Len(t, requests.epochs, numRequests)
+
+		// new hints should still be searched
+		response, err = bp.GetInternalMiniBlockByHashWithoutEpoch(0, testMiniBlockHash, common.MiniBlockEpochHints{BlockHash: testBlockHash}, common.Internal)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrMiniBlockNotFound))
+		require.Greater(t, len(requests.epochs), numRequests)
+	})
+}
@@ -0,0 +1,36 @@
+package mock
+
+// BlocksCacheStub -
+type BlocksCacheStub struct {
+	GetCalled        func(key string, value interface{}) bool
+	PutCalled        func(key string, value interface{})
+	PutIfFinalCalled func(shardID uint32, nonce uint64, key string, value interface{})
+}
+
+// Get -
+func (stub *BlocksCacheStub) Get(key string, value interface{}) bool {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(key, value)
+	}
+
+	return false
+}
+
+// Put -
+func (stub *BlocksCacheStub) Put(key string, value interface{}) {
+	if stub.PutCalled != nil {
+		stub.PutCalled(key, value)
+	}
+}
+
+// PutIfFinal -
+func (stub *BlocksCacheStub) PutIfFinal(shardID uint32, nonce uint64, key string, value interface{}) {
+	if stub.PutIfFinalCalled != nil {
+		stub.PutIfFinalCalled(shardID, nonce, key, value)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *BlocksCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+// HyperblocksRangeStreamerStub -
+type HyperblocksRangeStreamerStub struct {
+	StreamHyperBlocksCalled func(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error
+}
+
+// StreamHyperBlocks -
+func (stub *HyperblocksRangeStreamerStub) StreamHyperBlocks(fromNonce uint64, toNonce uint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+	if stub.StreamHyperBlocksCalled != nil {
+		return stub.StreamHyperBlocksCalled(fromNonce, toNonce, options, handler)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *HyperblocksRangeStreamerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -53,8 +53,14 @@ const (
 	// MetricAccountsSnapshotNumNodes is the metric that outputs the number of trie nodes written for accounts after snapshot
 	MetricAccountsSnapshotNumNodes = "erd_accounts_snapshot_num_nodes"
 
+	// MetricHighestFinalNonce is the metric for monitoring the highest final block nonce of a node
+	MetricHighestFinalNonce = "erd_highest_final_nonce"
+
 	// MetricNonce is the metric for monitoring the nonce of a node
 	MetricNonce = "erd_nonce"
+
+	// MetricEpochNumber is the metric for monitoring the current epoch of a node
+	MetricEpochNumber = "erd_epoch_number"
 )
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
@@ -390,6 +396,33 @@ func getTrieStatistics(nodeStatusData interface{}) (*data.TrieStatisticsAPIRespo
 	return trieStatistics, nil
 }
 
+// fetchNodeStatusUintMetric returns the value of the provided numeric metric, from the node status of an observer in the
+// given shard
+func fetchNodeStatusUintMetric(proc Processor, shardID uint32, metric string) (uint64, error) {
+	observers, err := proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	response := data.GenericAPIResponse{}
+	for _, observer := range observers {
+		_, err = proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &response)
+		if err != nil {
+			log.Error("node status request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		value, ok := getMetric(response.Data, metric)
+		if !ok {
+			return 0, ErrCannotParseNodeStatusMetrics
+		}
+
+		return getUint(value), nil
+	}
+
+	return 0, WrapObserversError(response.Error)
+}
+
 func getMetric(nodeStatusData interface{}, metric string) (interface{}, bool) {
 	metricsMapI, ok := nodeStatusData.(map[string]interface{})
 	if !ok {
@@ -515,3 +548,8 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 
 	return nil, WrapObserversError(responseEpochStartData.Error)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsp *NodeStatusProcessor) IsInterfaceNil() bool {
+	return nsp == nil
+}
@@ -0,0 +1,152 @@
+package process
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+// nonceReservationsCapacity defines for how many senders the next reserved nonce is kept in memory. When evicted, the
+// next reservation of a sender starts again from the chain state
+const nonceReservationsCapacity = 100000
+
+// nonceReservations keeps, for the most recently active senders, the next nonce that can be handed out by the proxy
+type nonceReservations struct {
+	mut        sync.Mutex
+	nextNonces ImmutableDataCacheHandler
+}
+
+func newNonceReservations(capacity int) (*nonceReservations, error) {
+	nextNonces, err := cache.NewLRUCache(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nonceReservations{
+		nextNonces: nextNonces,
+	}, nil
+}
+
+// reserve hands out count consecutive nonces, starting with the highest value between the provided minimum and the
+// next nonce already known for the sender. It returns the first reserved nonce
+func (nr *nonceReservations) reserve(sender string, minNonce uint64, count uint64) uint64 {
+	nr.mut.Lock()
+	defer nr.mut.Unlock()
+
+	firstNonce := minNonce
+	nextNonce, found := nr.nextNonces.Get(sender)
+	if found && nextNonce.(uint64) > firstNonce {
+		firstNonce = nextNonce.(uint64)
+	}
+	nr.nextNonces.Put(sender, firstNonce+count)
+
+	return firstNonce
+}
+
+// reset discards the reservations of the sender, the next reserved nonce will be the provided one
+func (nr *nonceReservations) reset(sender string, nextNonce uint64) {
+	nr.mut.Lock()
+	nr.nextNonces.Put(sender, nextNonce)
+	nr.mut.Unlock()
+}
+
+// ReserveNonces hands out count monotonically increasing nonces for the provided sender. The reservations are kept
+// in memory and are combined with the account nonce and the last nonce of the sender found in the transactions pool,
+// so that nonces already used on chain or pending in the pool are never handed out
+func (tp *TransactionProcessor) ReserveNonces(sender string, count uint64) (*data.NonceReservation, error) {
+	if count == 0 || count > common.MaxNonceReservationCount {
+		return nil, ErrInvalidNonceReservationCount
+	}
+
+	accountNonce, lastPoolNonce, err := tp.getNextNonceSources(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	firstNonce := tp.nonceReservations.reserve(sender, computeNextNonce(accountNonce, lastPoolNonce), count)
+
+	return &data.NonceReservation{
+		Address:       sender,
+		FirstNonce:    firstNonce,
+		LastNonce:     firstNonce + count - 1,
+		Count:         count,
+		AccountNonce:  accountNonce,
+		LastPoolNonce: lastPoolNonce,
+	}, nil
+}
+
+// SyncNonceReservations discards the nonces reserved for the provided sender and re-aligns the next reservation with
+// the chain state. It also returns the nonce gaps of the sender found in the transactions pool, which have to be
+// filled before the pending transactions can be executed
+func (tp *TransactionProcessor) SyncNonceReservations(sender string) (*data.NonceReservationSync, error) {
+	accountNonce, lastPoolNonce, err := tp.getNextNonceSources(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceGaps, err := tp.getTxPoolNonceGapsForSender(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := make([]data.NonceGap, 0)
+	if nonceGaps != nil {
+		gaps = append(gaps, nonceGaps.Gaps...)
+	}
+
+	nextNonce := computeNextNonce(accountNonce, lastPoolNonce)
+	tp.nonceReservations.reset(sender, nextNonce)
+
+	return &data.NonceReservationSync{
+		Address:       sender,
+		NextNonce:     nextNonce,
+		AccountNonce:  accountNonce,
+		LastPoolNonce: lastPoolNonce,
+		NonceGaps:     gaps,
+	}, nil
+}
+
+func (tp *TransactionProcessor) getNextNonceSources(sender string) (uint64, uint64, error) {
+	accountNonce, err := tp.getAccountNonce(sender)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lastPoolNonce, err := tp.getLastTxPoolNonceForSender(sender)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return accountNonce, lastPoolNonce, nil
+}
+
+func (tp *TransactionProcessor) getAccountNonce(sender string) (uint64, error) {
+	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
+	if err != nil {
+		return 0, err
+	}
+
+	responseAccount := data.AccountApiResponse{}
+	for _, observer := range observers {
+		_, err = tp.proc.CallGetRestEndPoint(observer.Address, addressPath+sender, &responseAccount)
+		if err == nil {
+			return responseAccount.Data.Account.Nonce, nil
+		}
+
+		log.Error("account nonce request", "observer", observer.Address, "address", sender, "error", err.Error())
+	}
+
+	return 0, WrapObserversError(responseAccount.Error)
+}
+
+// computeNextNonce returns the first nonce that is neither used on chain nor pending in the transactions pool.
+// The observers report a last pool nonce of 0 when the sender has no pending transactions, so that value is ignored
+func computeNextNonce(accountNonce uint64, lastPoolNonce uint64) uint64 {
+	if lastPoolNonce > 0 && lastPoolNonce >= accountNonce {
+		return lastPoolNonce + 1
+	}
+
+	return accountNonce
+}
@@ -0,0 +1,218 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nonceReservationSender = "erd1kwh72fxl5rwndatsgrvfu235q3pwyng9ax4zxcrg4ss3p6pwuugq3gt3yc"
+
+type nonceSourcesStub struct {
+	mut           sync.Mutex
+	accountNonce  uint64
+	lastPoolNonce uint64
+	gaps          []data.NonceGap
+}
+
+func (nss *nonceSourcesStub) setNonces(accountNonce uint64, lastPoolNonce uint64) {
+	nss.mut.Lock()
+	nss.accountNonce = accountNonce
+	nss.lastPoolNonce = lastPoolNonce
+	nss.mut.Unlock()
+}
+
+func createTransactionProcessorForNonceReservations(sources *nonceSourcesStub) *process.TransactionProcessor {
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				sources.mut.Lock()
+				defer sources.mut.Unlock()
+
+				switch {
+				case strings.Contains(path, "last-nonce"):
+					value.(*data.TransactionsPoolLastNonceForSenderApiResponse).Data.Nonce = sources.lastPoolNonce
+				case strings.Contains(path, "nonce-gaps"):
+					value.(*data.TransactionsPoolNonceGapsForSenderApiResponse).Data.NonceGaps.Gaps = sources.gaps
+				case strings.HasPrefix(path, "/address/"):
+					value.(*data.AccountApiResponse).Data.Account.Nonce = sources.accountNonce
+				default:
+					return http.StatusNotFound, errors.New("unexpected path")
+				}
+
+				return http.StatusOK, nil
+			},
+		},
+		testPubkeyConverter,
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		false,
+	)
+
+	return tp
+}
+
+func TestTransactionProcessor_ReserveNonces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid count should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createTransactionProcessorForNonceReservations(&nonceSourcesStub{})
+
+		reservation, err := tp.ReserveNonces(nonceReservationSender, 0)
+		require.Nil(t, reservation)
+		require.Equal(t, process.ErrInvalidNonceReservationCount, err)
+
+		reservation, err = tp.ReserveNonces(nonceReservationSender, common.MaxNonceReservationCount+1)
+		require.Nil(t, reservation)
+		require.Equal(t, process.ErrInvalidNonceReservationCount, err)
+	})
+	t.Run("should start from the account nonce and be monotonic", func(t *testing.T) {
+		t.Parallel()
+
+		sources := &nonceSourcesStub{}
+		sources.setNonces(10, 0)
+		tp := createTransactionProcessorForNonceReservations(sources)
+
+		reservation, err := tp.ReserveNonces(nonceReservationSender, 3)
+		require.Nil(t, err)
+		require.Equal(t, &data.NonceReservation{
+			Address:       nonceReservationSender,
+			FirstNonce:    10,
+			LastNonce:     12,
+			Count:         3,
+			AccountNonce:  10,
+			LastPoolNonce: 0,
+		}, reservation)
+
+		reservation, err = tp.ReserveNonces(nonceReservationSender, 1)
+		require.Nil(t, err)
+		require.Equal(t, uint64(13), reservation.FirstNonce)
+		require.Equal(t, uint64(13), reservation.LastNonce)
+	})
+	t.Run("should skip the nonces pending in the pool", func(t *testing.T) {
+		t.Parallel()
+
+		sources := &nonceSourcesStub{}
+		sources.setNonces(10, 20)
+		tp := createTransactionProcessorForNonceReservations(sources)
+
+		reservation, err := tp.ReserveNonces(nonceReservationSender, 2)
+		require.Nil(t, err)
+		require.Equal(t, uint64(21), reservation.FirstNonce)
+		require.Equal(t, uint64(22), reservation.LastNonce)
+	})
+	t.Run("should catch up with the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		sources := &nonceSourcesStub{}
+		sources.setNonces(10, 0)
+		tp := createTransactionProcessorForNonceReservations(sources)
+
+		_, _ = tp.ReserveNonces(nonceReservationSender, 2)
+		sources.setNonces(50, 0)
+
+		reservation, err := tp.ReserveNonces(nonceReservationSender, 1)
+		require.Nil(t, err)
+		require.Equal(t, uint64(50), reservation.FirstNonce)
+	})
+	t.Run("evicted sender should start again from the chain state", func(t *testing.T) {
+		t.Parallel()
+
+		otherSender := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+		sources := &nonceSourcesStub{}
+		sources.setNonces(10, 0)
+		tp := createTransactionProcessorForNonceReservations(sources)
+		require.Nil(t, tp.SetNonceReservationsCapacity(1))
+
+		_, _ = tp.ReserveNonces(nonceReservationSender, 5)
+		reservation, err := tp.ReserveNonces(otherSender, 1)
+		require.Nil(t, err)
+		require.Equal(t, uint64(10), reservation.FirstNonce)
+
+		reservation, err = tp.ReserveNonces(nonceReservationSender, 1)
+		require.Nil(t, err)
+		require.Equal(t, uint64(10), reservation.FirstNonce)
+	})
+	t.Run("concurrent reservations should not overlap", func(t *testing.T) {
+		t.Parallel()
+
+		sources := &nonceSourcesStub{}
+		sources.setNonces(5, 0)
+		tp := createTransactionProcessorForNonceReservations(sources)
+
+		numWorkers := 20
+		mutNonces := sync.Mutex{}
+		reservedNonces := make(map[uint64]struct{})
+		wg := sync.WaitGroup{}
+		wg.Add(numWorkers)
+		for i := 0; i < numWorkers; i++ {
+			go func() {
+				defer wg.Done()
+
+				reservation, err := tp.ReserveNonces(nonceReservationSender, 5)
+				if !assert.Nil(t, err) {
+					return
+				}
+
+				mutNonces.Lock()
+				for nonce := reservation.FirstNonce; nonce <= reservation.LastNonce; nonce++ {
+					reservedNonces[nonce] = struct{}{}
+				}
+				mutNonces.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		require.Len(t, reservedNonces, numWorkers*5)
+		for nonce := uint64(5); nonce < uint64(5+numWorkers*5); nonce++ {
+			_, found := reservedNonces[nonce]
+			require.True(t, found)
+		}
+	})
+}
+
+func TestTransactionProcessor_SyncNonceReservations(t *testing.T) {
+	t.Parallel()
+
+	providedGaps := []data.NonceGap{{From: 12, To: 13}}
+	sources := &nonceSourcesStub{gaps: providedGaps}
+	sources.setNonces(10, 15)
+	tp := createTransactionProcessorForNonceReservations(sources)
+
+	reservation, err := tp.ReserveNonces(nonceReservationSender, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(25), reservation.LastNonce)
+
+	syncResult, err := tp.SyncNonceReservations(nonceReservationSender)
+	require.Nil(t, err)
+	require.Equal(t, &data.NonceReservationSync{
+		Address:       nonceReservationSender,
+		NextNonce:     16,
+		AccountNonce:  10,
+		LastPoolNonce: 15,
+		NonceGaps:     providedGaps,
+	}, syncResult)
+
+	reservation, err = tp.ReserveNonces(nonceReservationSender, 1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(16), reservation.FirstNonce)
+}
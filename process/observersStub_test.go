@@ -0,0 +1,150 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// observerRouteHandler answers a request sent to the observer of the provided shard
+type observerRouteHandler func(shardID uint32, path string, value interface{}) (int, error)
+
+type observerRoute struct {
+	pathPrefix string
+	handler    observerRouteHandler
+}
+
+// observersStub is the fixture shared by the tests of the processors requesting the observers. It simulates one
+// observer per shard, named observer-<shard>, which answers the node status requests with the configured metrics and
+// any other request with the handler registered for the longest matching path prefix. Unexpected paths fail the test
+type observersStub struct {
+	t                     *testing.T
+	shardIDs              []uint32
+	mutMetrics            sync.RWMutex
+	metrics               map[string]uint64
+	shardMetrics          map[uint32]map[string]uint64
+	routes                []observerRoute
+	numNodeStatusRequests uint32
+}
+
+func newObserversStub(t *testing.T, shardIDs ...uint32) *observersStub {
+	return &observersStub{
+		t:            t,
+		shardIDs:     shardIDs,
+		metrics:      make(map[string]uint64),
+		shardMetrics: make(map[uint32]map[string]uint64),
+	}
+}
+
+// setMetric sets the value of a node status metric reported by all the observers
+func (stub *observersStub) setMetric(metric string, value uint64) {
+	stub.mutMetrics.Lock()
+	stub.metrics[metric] = value
+	stub.mutMetrics.Unlock()
+}
+
+// setShardMetric sets the value of a node status metric reported by the observer of the provided shard
+func (stub *observersStub) setShardMetric(shardID uint32, metric string, value uint64) {
+	stub.mutMetrics.Lock()
+	if stub.shardMetrics[shardID] == nil {
+		stub.shardMetrics[shardID] = make(map[string]uint64)
+	}
+	stub.shardMetrics[shardID][metric] = value
+	stub.mutMetrics.Unlock()
+}
+
+// handle registers the handler of the requests whose path starts with the provided prefix. It should be called before
+// the processor stub is used
+func (stub *observersStub) handle(pathPrefix string, handler observerRouteHandler) {
+	stub.routes = append(stub.routes, observerRoute{pathPrefix: pathPrefix, handler: handler})
+}
+
+func (stub *observersStub) getNumNodeStatusRequests() int {
+	return int(atomic.LoadUint32(&stub.numNodeStatusRequests))
+}
+
+func (stub *observersStub) getNodes(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	return []*data.NodeData{{ShardId: shardID, Address: fmt.Sprintf("observer-%d", shardID)}}, nil
+}
+
+func (stub *observersStub) getAllNodes(_ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	nodes := make([]*data.NodeData, 0, len(stub.shardIDs))
+	for _, shardID := range stub.shardIDs {
+		nodes = append(nodes, &data.NodeData{ShardId: shardID, Address: fmt.Sprintf("observer-%d", shardID)})
+	}
+
+	return nodes, nil
+}
+
+func (stub *observersStub) callGetRestEndPoint(address string, path string, value interface{}) (int, error) {
+	shardID := uint32(0)
+	_, err := fmt.Sscanf(address, "observer-%d", &shardID)
+	assert.Nil(stub.t, err)
+
+	if path == process.NodeStatusPath {
+		atomic.AddUint32(&stub.numNodeStatusRequests, 1)
+		response := value.(*data.GenericAPIResponse)
+		response.Data = map[string]interface{}{
+			"metrics": stub.getMetrics(shardID),
+		}
+		return 200, nil
+	}
+
+	var matchingRoute *observerRoute
+	for i := range stub.routes {
+		route := &stub.routes[i]
+		if !strings.HasPrefix(path, route.pathPrefix) {
+			continue
+		}
+		if matchingRoute == nil || len(route.pathPrefix) > len(matchingRoute.pathPrefix) {
+			matchingRoute = route
+		}
+	}
+	if matchingRoute == nil {
+		assert.Fail(stub.t, "unexpected path "+path)
+		return 404, errors.New("unexpected path")
+	}
+
+	return matchingRoute.handler(shardID, path, value)
+}
+
+func (stub *observersStub) getMetrics(shardID uint32) map[string]interface{} {
+	stub.mutMetrics.RLock()
+	defer stub.mutMetrics.RUnlock()
+
+	metrics := make(map[string]interface{})
+	for metric, value := range stub.metrics {
+		metrics[metric] = float64(value)
+	}
+	for metric, value := range stub.shardMetrics[shardID] {
+		metrics[metric] = float64(value)
+	}
+
+	return metrics
+}
+
+// processor returns a processor stub sending its requests to the simulated observers and converting the addresses
+// to bech32
+func (stub *observersStub) processor() *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetPubKeyConverterCalled: func() core.PubkeyConverter {
+			return testPubkeyConverter
+		},
+		GetShardIDsCalled: func() []uint32 {
+			return stub.shardIDs
+		},
+		GetObserversCalled:        stub.getNodes,
+		GetFullHistoryNodesCalled: stub.getNodes,
+		GetAllObserversCalled:     stub.getAllNodes,
+		CallGetRestEndPointCalled: stub.callGetRestEndPoint,
+	}
+}
@@ -0,0 +1,58 @@
+package process
+
+type orderedFetchResult[T any] struct {
+	item T
+	err  error
+}
+
+// fetchOrdered fetches the items between the provided indexes (both included) concurrently, with at most
+// maxConcurrentRequests requests in flight, and hands them, in index order, to the provided handler. The fetching stops
+// at the first fetching or handler error, which is returned as is
+func fetchOrdered[T any](
+	fromIndex uint64,
+	toIndex uint64,
+	maxConcurrentRequests int,
+	fetch func(index uint64) (T, error),
+	handler func(item T) error,
+) error {
+	numItems := int(toIndex - fromIndex + 1)
+	results := make([]chan orderedFetchResult[T], numItems)
+	for i := range results {
+		results[i] = make(chan orderedFetchResult[T], 1)
+	}
+
+	throttler := make(chan struct{}, maxConcurrentRequests)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for i := 0; i < numItems; i++ {
+			select {
+			case throttler <- struct{}{}:
+			case <-done:
+				return
+			}
+
+			go func(idx int) {
+				item, err := fetch(fromIndex + uint64(idx))
+				results[idx] <- orderedFetchResult[T]{item: item, err: err}
+			}(i)
+		}
+	}()
+
+	for i := 0; i < numItems; i++ {
+		result := <-results[i]
+		<-throttler
+
+		if result.err != nil {
+			return result.err
+		}
+
+		err := handler(result.item)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
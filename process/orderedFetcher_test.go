@@ -0,0 +1,78 @@
+package process
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchOrdered(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should hand the items in order and throttle the requests", func(t *testing.T) {
+		t.Parallel()
+
+		var numInFlight, maxInFlight int32
+		fetch := func(index uint64) (uint64, error) {
+			inFlight := atomic.AddInt32(&numInFlight, 1)
+			defer atomic.AddInt32(&numInFlight, -1)
+			for {
+				currentMax := atomic.LoadInt32(&maxInFlight)
+				if inFlight <= currentMax || atomic.CompareAndSwapInt32(&maxInFlight, currentMax, inFlight) {
+					break
+				}
+			}
+
+			// the later items are fetched faster, so they complete out of order
+			time.Sleep(time.Millisecond * time.Duration(30-index))
+			return index * 10, nil
+		}
+
+		handled := make([]uint64, 0)
+		err := fetchOrdered(5, 24, 4, fetch, func(item uint64) error {
+			handled = append(handled, item)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, handled, 20)
+		for i, item := range handled {
+			require.Equal(t, uint64(i+5)*10, item)
+		}
+		require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(4))
+	})
+	t.Run("fetching error should stop", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		handled := make([]uint64, 0)
+		err := fetchOrdered(0, 9, 3, func(index uint64) (uint64, error) {
+			if index == 2 {
+				return 0, expectedErr
+			}
+
+			return index, nil
+		}, func(item uint64) error {
+			handled = append(handled, item)
+			return nil
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, []uint64{0, 1}, handled)
+	})
+	t.Run("handler error should stop", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		var numFetched int32
+		err := fetchOrdered(0, 99, 2, func(index uint64) (uint64, error) {
+			atomic.AddInt32(&numFetched, 1)
+			return index, nil
+		}, func(item uint64) error {
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Less(t, atomic.LoadInt32(&numFetched), int32(100))
+	})
+}
@@ -0,0 +1,304 @@
+package process
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/guardians"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	esdtStorageKeyPrefix         = core.ProtectedKeyPrefix + core.ESDTKeyIdentifier
+	esdtRolesStorageKeyPrefix    = core.ProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier
+	nftLastNonceStorageKeyPrefix = core.ProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier
+	guardiansStorageKey          = core.ProtectedKeyPrefix + core.GuardiansKeyIdentifier
+
+	tokenIdentifierSeparator  = '-'
+	tokenRandomSequenceLength = 6
+	maxNonceBytesLength       = 8
+)
+
+var errUnknownStorageKey = errors.New("unknown storage key")
+
+// GetDecodedKeyValuePairs returns all the key-value pairs of the given address, decoding the ones reserved by the protocol
+func (ap *AccountProcessor) GetDecodedKeyValuePairs(address string, options common.AccountQueryOptions) (*data.DecodedKeyValuePairs, error) {
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	isSystemAccount, err := ap.isSystemAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse := data.AccountKeyValuePairsApiResponse{}
+	apiPath := common.BuildUrlWithAccountQueryOptions(addressPath+address+"/keys", options)
+	for _, observer := range observers {
+		respCode, errGet := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		if errGet == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			log.Info("account get decoded key-value pairs",
+				"address", address,
+				"shard ID", observer.ShardId,
+				"observer", observer.Address,
+				"http code", respCode)
+			if apiResponse.Error != "" {
+				return nil, errors.New(apiResponse.Error)
+			}
+
+			decodedPairs := make(map[string]*data.DecodedStorageEntry, len(apiResponse.Data.Pairs))
+			for key, value := range apiResponse.Data.Pairs {
+				decodedPairs[key] = ap.decodeStorageEntry(key, value, isSystemAccount)
+			}
+
+			return &data.DecodedKeyValuePairs{
+				Pairs:     decodedPairs,
+				BlockInfo: apiResponse.Data.BlockInfo,
+			}, nil
+		}
+
+		log.Error("account get decoded key-value pairs error", "observer", observer.Address, "address", address, "error", errGet.Error())
+	}
+
+	return nil, WrapObserversError(apiResponse.Error)
+}
+
+// GetDecodedValueForKey returns the value for the given address and key, decoded if the key is reserved by the protocol
+func (ap *AccountProcessor) GetDecodedValueForKey(address string, key string, options common.AccountQueryOptions) (*data.DecodedStorageEntry, error) {
+	isSystemAccount, err := ap.isSystemAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := ap.GetValueForKey(address, key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return ap.decodeStorageEntry(key, value, isSystemAccount), nil
+}
+
+func (ap *AccountProcessor) isSystemAccount(address string) (bool, error) {
+	addressBytes, err := ap.pubKeyConverter.Decode(address)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(addressBytes, core.SystemAccountAddress), nil
+}
+
+// decodeStorageEntry decodes the hex encoded key-value pair. Keys that are not reserved by the protocol, or whose
+// values cannot be decoded, are returned as unknown
+func (ap *AccountProcessor) decodeStorageEntry(keyHex string, valueHex string, isSystemAccount bool) *data.DecodedStorageEntry {
+	entry := &data.DecodedStorageEntry{
+		Key:   keyHex,
+		Value: valueHex,
+		Type:  data.StorageEntryTypeUnknown,
+	}
+
+	key, errKey := hex.DecodeString(keyHex)
+	value, errValue := hex.DecodeString(valueHex)
+	if errKey != nil || errValue != nil {
+		return entry
+	}
+
+	var err error
+	switch {
+	case bytes.HasPrefix(key, []byte(esdtRolesStorageKeyPrefix)):
+		err = decodeESDTRolesEntry(entry, key[len(esdtRolesStorageKeyPrefix):], value)
+	case bytes.HasPrefix(key, []byte(esdtStorageKeyPrefix)):
+		err = ap.decodeESDTEntry(entry, key[len(esdtStorageKeyPrefix):], value, isSystemAccount)
+	case bytes.HasPrefix(key, []byte(nftLastNonceStorageKeyPrefix)):
+		err = decodeNFTLastNonceEntry(entry, key[len(nftLastNonceStorageKeyPrefix):], value)
+	case bytes.Equal(key, []byte(guardiansStorageKey)):
+		err = ap.decodeGuardiansEntry(entry, value)
+	default:
+		err = errUnknownStorageKey
+	}
+	if err == nil {
+		return entry
+	}
+
+	unknownEntry := &data.DecodedStorageEntry{
+		Key:   keyHex,
+		Value: valueHex,
+		Type:  data.StorageEntryTypeUnknown,
+	}
+	if isPrintableText(key) {
+		unknownEntry.KeyText = string(key)
+	}
+	if isPrintableText(value) {
+		unknownEntry.ValueText = string(value)
+	}
+
+	return unknownEntry
+}
+
+func (ap *AccountProcessor) decodeESDTEntry(entry *data.DecodedStorageEntry, keySuffix []byte, value []byte, isSystemAccount bool) error {
+	tokenIdentifier, nonce, err := splitTokenIdentifierAndNonce(keySuffix)
+	if err != nil {
+		return err
+	}
+
+	token := &esdt.ESDigitalToken{}
+	err = token.Unmarshal(value)
+	if err != nil {
+		return err
+	}
+
+	balance := big.NewInt(0)
+	if token.Value != nil {
+		balance = token.Value
+	}
+
+	entry.Type = data.StorageEntryTypeESDT
+	if nonce > 0 {
+		entry.Type = data.StorageEntryTypeNFT
+		if isSystemAccount {
+			entry.Type = data.StorageEntryTypeESDTMetaData
+		}
+	}
+	entry.TokenIdentifier = tokenIdentifier
+	entry.Nonce = nonce
+	entry.Token = &data.AccountESDTToken{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         balance.String(),
+		Properties:      hex.EncodeToString(token.Properties),
+		Nonce:           nonce,
+	}
+	if nonce > 0 {
+		entry.Token.TokenIdentifier = fmt.Sprintf("%s-%s", tokenIdentifier, hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes()))
+	}
+
+	metaData := token.TokenMetaData
+	if metaData == nil {
+		return nil
+	}
+
+	entry.Token.Name = string(metaData.Name)
+	entry.Token.Royalties = fmt.Sprintf("%d", metaData.Royalties)
+	entry.Token.Hash = metaData.Hash
+	entry.Token.URIs = metaData.URIs
+	entry.Token.Attributes = metaData.Attributes
+	entry.Token.DecodedAttributes = decodeNFTAttributes(metaData.Attributes)
+	if len(metaData.Creator) > 0 {
+		entry.Token.Creator, err = ap.pubKeyConverter.Encode(metaData.Creator)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeESDTRolesEntry(entry *data.DecodedStorageEntry, tokenIdentifier []byte, value []byte) error {
+	if !isValidTokenIdentifier(tokenIdentifier) {
+		return errUnknownStorageKey
+	}
+
+	roles := &esdt.ESDTRoles{}
+	err := roles.Unmarshal(value)
+	if err != nil {
+		return err
+	}
+
+	entry.Type = data.StorageEntryTypeESDTRoles
+	entry.TokenIdentifier = string(tokenIdentifier)
+	entry.Roles = make([]string, 0, len(roles.Roles))
+	for _, role := range roles.Roles {
+		entry.Roles = append(entry.Roles, string(role))
+	}
+
+	return nil
+}
+
+func decodeNFTLastNonceEntry(entry *data.DecodedStorageEntry, tokenIdentifier []byte, value []byte) error {
+	if !isValidTokenIdentifier(tokenIdentifier) || len(value) > maxNonceBytesLength {
+		return errUnknownStorageKey
+	}
+
+	entry.Type = data.StorageEntryTypeNFTLastNonce
+	entry.TokenIdentifier = string(tokenIdentifier)
+	entry.Nonce = big.NewInt(0).SetBytes(value).Uint64()
+
+	return nil
+}
+
+func (ap *AccountProcessor) decodeGuardiansEntry(entry *data.DecodedStorageEntry, value []byte) error {
+	accountGuardians := &guardians.Guardians{}
+	err := accountGuardians.Unmarshal(value)
+	if err != nil {
+		return err
+	}
+
+	entry.Type = data.StorageEntryTypeGuardians
+	entry.Guardians = make([]*data.Guardian, 0, len(accountGuardians.Slice))
+	for _, guardian := range accountGuardians.Slice {
+		if guardian == nil {
+			continue
+		}
+
+		guardianAddress, errEncode := ap.pubKeyConverter.Encode(guardian.Address)
+		if errEncode != nil {
+			return errEncode
+		}
+
+		entry.Guardians = append(entry.Guardians, &data.Guardian{
+			Address:         guardianAddress,
+			ActivationEpoch: guardian.ActivationEpoch,
+			ServiceUID:      string(guardian.ServiceUID),
+		})
+	}
+
+	return nil
+}
+
+// splitTokenIdentifierAndNonce splits the suffix of an ESDT storage key into the token identifier, optionally
+// prefixed ("prefix-TICKER-abcdef"), and the big endian encoded nonce that follows it (if any)
+func splitTokenIdentifierAndNonce(keySuffix []byte) (string, uint64, error) {
+	separatorIndex := bytes.IndexByte(keySuffix, tokenIdentifierSeparator)
+	if separatorIndex < 0 {
+		return "", 0, errUnknownStorageKey
+	}
+
+	if esdt.IsValidTokenPrefix(string(keySuffix[:separatorIndex])) {
+		tickerEnd := bytes.IndexByte(keySuffix[separatorIndex+1:], tokenIdentifierSeparator)
+		if tickerEnd >= 0 {
+			separatorIndex += tickerEnd + 1
+		}
+	}
+
+	identifierLength := separatorIndex + 1 + tokenRandomSequenceLength
+	if len(keySuffix) < identifierLength || len(keySuffix)-identifierLength > maxNonceBytesLength {
+		return "", 0, errUnknownStorageKey
+	}
+
+	tokenIdentifier := keySuffix[:identifierLength]
+	if !isValidTokenIdentifier(tokenIdentifier) {
+		return "", 0, errUnknownStorageKey
+	}
+
+	nonce := big.NewInt(0).SetBytes(keySuffix[identifierLength:]).Uint64()
+
+	return string(tokenIdentifier), nonce, nil
+}
+
+func isValidTokenIdentifier(tokenIdentifier []byte) bool {
+	parts := strings.Split(string(tokenIdentifier), string(tokenIdentifierSeparator))
+	if len(parts) == 3 {
+		_, isValid := esdt.IsValidPrefixedToken(string(tokenIdentifier))
+		return isValid
+	}
+
+	return len(parts) == 2 && esdt.IsTickerValid(parts[0]) && esdt.IsRandomSeqValid(parts[1])
+}
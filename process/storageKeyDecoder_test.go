@@ -0,0 +1,210 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/guardians"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountProcessorWithPairs(t *testing.T, pairs map[string]string) *process.AccountProcessor {
+	ap, err := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.AccountKeyValuePairsApiResponse)
+				response.Data.Pairs = pairs
+				response.Data.BlockInfo = data.BlockInfo{Nonce: 37}
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+	)
+	require.Nil(t, err)
+
+	return ap
+}
+
+func hexKey(key ...[]byte) string {
+	keyBytes := make([]byte, 0)
+	for _, part := range key {
+		keyBytes = append(keyBytes, part...)
+	}
+
+	return hex.EncodeToString(keyBytes)
+}
+
+func marshalForTest(t *testing.T, value interface{ Marshal() ([]byte, error) }) string {
+	buff, err := value.Marshal()
+	require.Nil(t, err)
+
+	return hex.EncodeToString(buff)
+}
+
+func TestAccountProcessor_GetDecodedKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					response := value.(*data.AccountKeyValuePairsApiResponse)
+					response.Error = "trie error"
+
+					return http.StatusInternalServerError, errors.New("trie error")
+				},
+			},
+			&mock.PubKeyConverterMock{},
+		)
+
+		pairs, err := ap.GetDecodedKeyValuePairs("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, pairs)
+		require.Equal(t, "trie error", err.Error())
+	})
+	t.Run("should decode the protocol keys", func(t *testing.T) {
+		t.Parallel()
+
+		esdtPrefix := []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier)
+		fungibleKey := hexKey(esdtPrefix, []byte("WEGLD-abcdef"))
+		nftKey := hexKey(esdtPrefix, []byte("NFT-abcdef"), []byte{0x01, 0x0a})
+		rolesKey := hexKey([]byte(core.ProtectedKeyPrefix+core.ESDTRoleIdentifier+core.ESDTKeyIdentifier), []byte("MEX-abcdef"))
+		lastNonceKey := hexKey([]byte(core.ProtectedKeyPrefix+core.ESDTNFTLatestNonceIdentifier), []byte("NFT-abcdef"))
+		guardiansKey := hexKey([]byte(core.ProtectedKeyPrefix + core.GuardiansKeyIdentifier))
+		printableKey := hexKey([]byte("counter"))
+		binaryKey := hexKey([]byte{0x00, 0xff})
+		invalidESDTKey := hexKey(esdtPrefix, []byte("not a token"))
+
+		ap := createAccountProcessorWithPairs(t, map[string]string{
+			fungibleKey: marshalForTest(t, &esdt.ESDigitalToken{Value: big.NewInt(1000)}),
+			nftKey: marshalForTest(t, &esdt.ESDigitalToken{
+				Value: big.NewInt(1),
+				TokenMetaData: &esdt.MetaData{
+					Nonce:      266,
+					Name:       []byte("art"),
+					Creator:    []byte{0xaa, 0xbb},
+					Royalties:  500,
+					Attributes: []byte("tags:art"),
+				},
+			}),
+			rolesKey:       marshalForTest(t, &esdt.ESDTRoles{Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}),
+			lastNonceKey:   hex.EncodeToString([]byte{0x01, 0x0a}),
+			guardiansKey:   marshalForTest(t, &guardians.Guardians{Slice: []*guardians.Guardian{{Address: []byte{0xcc}, ActivationEpoch: 5, ServiceUID: []byte("uid")}}}),
+			printableKey:   hex.EncodeToString([]byte("value")),
+			binaryKey:      "00ff",
+			invalidESDTKey: "0a",
+		})
+
+		decoded, err := ap.GetDecodedKeyValuePairs("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint64(37), decoded.BlockInfo.Nonce)
+		require.Len(t, decoded.Pairs, 8)
+
+		fungible := decoded.Pairs[fungibleKey]
+		require.Equal(t, data.StorageEntryTypeESDT, fungible.Type)
+		require.Equal(t, "WEGLD-abcdef", fungible.TokenIdentifier)
+		require.Equal(t, "1000", fungible.Token.Balance)
+
+		nft := decoded.Pairs[nftKey]
+		require.Equal(t, data.StorageEntryTypeNFT, nft.Type)
+		require.Equal(t, "NFT-abcdef", nft.TokenIdentifier)
+		require.Equal(t, uint64(266), nft.Nonce)
+		require.Equal(t, "NFT-abcdef-010a", nft.Token.TokenIdentifier)
+		require.Equal(t, "art", nft.Token.Name)
+		require.Equal(t, "aabb", nft.Token.Creator)
+		require.Equal(t, "500", nft.Token.Royalties)
+		require.Equal(t, &data.NFTAttributes{Text: "tags:art", Fields: map[string]string{"tags": "art"}}, nft.Token.DecodedAttributes)
+
+		roles := decoded.Pairs[rolesKey]
+		require.Equal(t, data.StorageEntryTypeESDTRoles, roles.Type)
+		require.Equal(t, "MEX-abcdef", roles.TokenIdentifier)
+		require.Equal(t, []string{core.ESDTRoleLocalMint}, roles.Roles)
+
+		lastNonce := decoded.Pairs[lastNonceKey]
+		require.Equal(t, data.StorageEntryTypeNFTLastNonce, lastNonce.Type)
+		require.Equal(t, uint64(266), lastNonce.Nonce)
+
+		accountGuardians := decoded.Pairs[guardiansKey]
+		require.Equal(t, data.StorageEntryTypeGuardians, accountGuardians.Type)
+		require.Equal(t, []*data.Guardian{{Address: "cc", ActivationEpoch: 5, ServiceUID: "uid"}}, accountGuardians.Guardians)
+
+		printable := decoded.Pairs[printableKey]
+		require.Equal(t, data.StorageEntryTypeUnknown, printable.Type)
+		require.Equal(t, "counter", printable.KeyText)
+		require.Equal(t, "value", printable.ValueText)
+
+		binary := decoded.Pairs[binaryKey]
+		require.Equal(t, &data.DecodedStorageEntry{Key: binaryKey, Value: "00ff", Type: data.StorageEntryTypeUnknown}, binary)
+
+		invalidESDT := decoded.Pairs[invalidESDTKey]
+		require.Equal(t, data.StorageEntryTypeUnknown, invalidESDT.Type)
+		require.Nil(t, invalidESDT.Token)
+	})
+	t.Run("system account should decode the NFT metadata", func(t *testing.T) {
+		t.Parallel()
+
+		nftKey := hexKey([]byte(core.ProtectedKeyPrefix+core.ESDTKeyIdentifier), []byte("NFT-abcdef"), []byte{0x01})
+		ap := createAccountProcessorWithPairs(t, map[string]string{
+			nftKey: marshalForTest(t, &esdt.ESDigitalToken{TokenMetaData: &esdt.MetaData{Nonce: 1, Name: []byte("art")}}),
+		})
+
+		decoded, err := ap.GetDecodedKeyValuePairs(hex.EncodeToString(core.SystemAccountAddress), common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, data.StorageEntryTypeESDTMetaData, decoded.Pairs[nftKey].Type)
+		require.Equal(t, "0", decoded.Pairs[nftKey].Token.Balance)
+		require.Equal(t, "art", decoded.Pairs[nftKey].Token.Name)
+	})
+}
+
+func TestAccountProcessor_GetDecodedValueForKey(t *testing.T) {
+	t.Parallel()
+
+	lastNonceKey := hexKey([]byte(core.ProtectedKeyPrefix+core.ESDTNFTLatestNonceIdentifier), []byte("NFT-abcdef"))
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.AccountKeyValueResponse)
+				response.Data.Value = hex.EncodeToString([]byte{0x01, 0x0a})
+
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+	)
+
+	entry, err := ap.GetDecodedValueForKey("DEADBEEF", lastNonceKey, common.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, data.StorageEntryTypeNFTLastNonce, entry.Type)
+	require.Equal(t, "NFT-abcdef", entry.TokenIdentifier)
+	require.Equal(t, uint64(266), entry.Nonce)
+	require.Equal(t, lastNonceKey, entry.Key)
+}
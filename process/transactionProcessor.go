@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/http"
 	"sort"
+	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -85,6 +86,7 @@ type TransactionProcessor struct {
 	newTxCostProcessor           func() (TransactionCostHandler, error)
 	mergeLogsHandler             LogsMergerHandler
 	shouldAllowEntireTxPoolFetch bool
+	nonceReservations            *nonceReservations
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -119,6 +121,11 @@ func NewTransactionProcessor(
 	// no reason to get this from configs. If we are going to change the marshaller for the relayed transaction v1,
 	// we will need also an enable epoch handler
 	relayedTxsMarshaller := &marshal.JsonMarshalizer{}
+	nonceReservations, err := newNonceReservations(nonceReservationsCapacity)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TransactionProcessor{
 		proc:                         proc,
 		pubKeyConverter:              pubKeyConverter,
@@ -128,6 +135,7 @@ func NewTransactionProcessor(
 		mergeLogsHandler:             logsMerger,
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
 		relayedTxsMarshaller:         relayedTxsMarshaller,
+		nonceReservations:            nonceReservations,
 	}, nil
 }
 
@@ -181,77 +189,108 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 
 // SimulateTransaction relays the post request by sending the request to the right observer and replies back the answer
 func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
-	err := tp.checkTransactionFields(tx)
+	_, simulation, err := tp.simulateOnShards(tx, checkSignature)
 	if err != nil {
 		return nil, err
 	}
 
+	if simulation.senderShardID == simulation.receiverShardID {
+		return &data.GenericAPIResponse{
+			Data:  simulation.senderShardResponse.Data,
+			Error: simulation.senderShardResponse.Error,
+			Code:  simulation.senderShardResponse.Code,
+		}, nil
+	}
+
+	simulationResult := data.ResponseTransactionSimulationCrossShard{}
+	simulationResult.Data.Result = map[string]data.TransactionSimulationResults{
+		"senderShard":   simulation.senderShardResponse.Data.Result,
+		"receiverShard": simulation.receiverShardResponse.Data.Result,
+	}
+
+	return &data.GenericAPIResponse{
+		Data:  simulationResult.Data,
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}, nil
+}
+
+type shardsSimulation struct {
+	senderShardID         uint32
+	receiverShardID       uint32
+	senderShardObserver   *data.NodeData
+	senderShardResponse   *data.ResponseTransactionSimulation
+	receiverShardResponse *data.ResponseTransactionSimulation
+}
+
+// simulateOnShards simulates the transaction on the sender shard and, for cross-shard transactions, on the receiver
+// shard. The returned status code is the one SendTransaction would have responded with for the same error
+func (tp *TransactionProcessor) simulateOnShards(tx *data.Transaction, checkSignature bool) (int, *shardsSimulation, error) {
+	err := tp.checkTransactionFields(tx)
+	if err != nil {
+		return http.StatusBadRequest, nil, err
+	}
+
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
-		return nil, err
+		return http.StatusBadRequest, nil, err
 	}
 
 	senderShardID, err := tp.proc.ComputeShardId(senderBuff)
 	if err != nil {
-		return nil, err
+		return http.StatusInternalServerError, nil, err
 	}
 
-	observers, err := tp.proc.GetObservers(senderShardID, data.AvailabilityRecent)
+	receiverBuff, err := tp.pubKeyConverter.Decode(tx.Receiver)
 	if err != nil {
-		return nil, err
+		return http.StatusBadRequest, nil, err
 	}
 
-	response, err := tp.simulateTransaction(observers, tx, checkSignature)
+	receiverShardID, err := tp.proc.ComputeShardId(receiverBuff)
 	if err != nil {
-		return nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
+		return http.StatusInternalServerError, nil, err
 	}
 
-	receiverBuff, err := tp.pubKeyConverter.Decode(tx.Receiver)
+	observers, err := tp.proc.GetObservers(senderShardID, data.AvailabilityRecent)
 	if err != nil {
-		return nil, err
+		return http.StatusInternalServerError, nil, err
 	}
 
-	receiverShardID, err := tp.proc.ComputeShardId(receiverBuff)
+	// the observers are tried in the same order as when sending, so the one that answers is the one that would have
+	// received the transaction
+	response, observer, err := tp.simulateTransaction(observers, tx, checkSignature)
 	if err != nil {
-		return nil, err
+		return http.StatusInternalServerError, nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
 	}
 
+	simulation := &shardsSimulation{
+		senderShardID:       senderShardID,
+		receiverShardID:     receiverShardID,
+		senderShardObserver: observer,
+		senderShardResponse: response,
+	}
 	if senderShardID == receiverShardID {
-		return &data.GenericAPIResponse{
-			Data:  response.Data,
-			Error: response.Error,
-			Code:  response.Code,
-		}, nil
+		return http.StatusOK, simulation, nil
 	}
 
 	observersForReceiverShard, err := tp.proc.GetObservers(receiverShardID, data.AvailabilityRecent)
 	if err != nil {
-		return nil, err
+		return http.StatusInternalServerError, nil, err
 	}
 
-	responseFromReceiverShard, err := tp.simulateTransaction(observersForReceiverShard, tx, checkSignature)
+	simulation.receiverShardResponse, _, err = tp.simulateTransaction(observersForReceiverShard, tx, checkSignature)
 	if err != nil {
-		return nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
-	}
-
-	simulationResult := data.ResponseTransactionSimulationCrossShard{}
-	simulationResult.Data.Result = map[string]data.TransactionSimulationResults{
-		"senderShard":   response.Data.Result,
-		"receiverShard": responseFromReceiverShard.Data.Result,
+		return http.StatusInternalServerError, nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
 	}
 
-	return &data.GenericAPIResponse{
-		Data:  simulationResult.Data,
-		Error: "",
-		Code:  data.ReturnCodeSuccess,
-	}, nil
+	return http.StatusOK, simulation, nil
 }
 
 func (tp *TransactionProcessor) simulateTransaction(
 	observers []*data.NodeData,
 	tx *data.Transaction,
 	checkSignature bool,
-) (*data.ResponseTransactionSimulation, error) {
+) (*data.ResponseTransactionSimulation, *data.NodeData, error) {
 	txSimulatePath := TransactionSimulatePath
 	if !checkSignature {
 		txSimulatePath += checkSignatureFalse
@@ -267,7 +306,7 @@ func (tp *TransactionProcessor) simulateTransaction(
 				observer.ShardId,
 				txResponse.Data.Result.Hash,
 			))
-			return &txResponse, nil
+			return &txResponse, observer, nil
 		}
 
 		// if observer was down (or didn't respond in time), skip to the next one
@@ -277,10 +316,66 @@ func (tp *TransactionProcessor) simulateTransaction(
 		}
 
 		// if the request was bad, return the error message
-		return nil, err
+		return nil, nil, err
 	}
 
-	return nil, WrapObserversError(txResponse.Error)
+	return nil, nil, WrapObserversError(txResponse.Error)
+}
+
+// DryRunTransaction runs the transaction through the same pipeline as SendTransaction (fields checks, shard routing and
+// observer selection) but, instead of broadcasting it, it simulates it with signature check on the selected observer.
+// As for SendTransaction, the returned status code reflects the kind of error
+func (tp *TransactionProcessor) DryRunTransaction(tx *data.Transaction) (int, *data.TransactionDryRunResult, error) {
+	statusCode, simulation, err := tp.simulateOnShards(tx, true)
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	result := &data.TransactionDryRunResult{
+		TxHash:          simulation.senderShardResponse.Data.Result.Hash,
+		SenderShardID:   simulation.senderShardID,
+		ReceiverShardID: simulation.receiverShardID,
+		Observer:        simulation.senderShardObserver.Address,
+		SimulationResults: map[string]data.TransactionSimulationResults{
+			"senderShard": simulation.senderShardResponse.Data.Result,
+		},
+	}
+	if simulation.receiverShardResponse != nil {
+		result.SimulationResults["receiverShard"] = simulation.receiverShardResponse.Data.Result
+	}
+
+	return http.StatusOK, result, nil
+}
+
+// DryRunMultipleTransactions dry-runs each of the provided transactions. The results and the errors are indexed by
+// the position of the transaction in the provided slice
+func (tp *TransactionProcessor) DryRunMultipleTransactions(txs []*data.Transaction) (*data.MultipleTransactionsDryRunResponseData, error) {
+	if len(txs) == 0 {
+		return nil, ErrNoValidTransactionToSend
+	}
+
+	response := &data.MultipleTransactionsDryRunResponseData{
+		Results: make(map[int]*data.TransactionDryRunResult),
+		Errors:  make(map[int]string),
+	}
+
+	for idx, tx := range txs {
+		_, result, err := tp.DryRunTransaction(tx)
+		if err != nil {
+			log.Debug("dry run of transaction failed",
+				"index", idx,
+				"sender", tx.Sender,
+				"receiver", tx.Receiver,
+				"error", err)
+			response.Errors[idx] = err.Error()
+			continue
+		}
+
+		response.Results[idx] = result
+		response.NumOfTxs++
+	}
+
+	return response, nil
 }
 
 // SendMultipleTransactions relays the post request by sending the request to the first available observer and replies back the answer
@@ -447,6 +542,11 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 	if tx.Status == transaction.TxStatusInvalid {
 		return &data.ProcessStatusResponse{
 			Status: string(transaction.TxStatusFail),
+			Failure: &data.ProcessStatusFailureDetails{
+				Rule:       data.FailureRuleInvalidTransaction,
+				SourceHash: tx.Hash,
+				Message:    tx.ReturnMessage,
+			},
 		}
 	}
 	if tx.Status != transaction.TxStatusSuccess {
@@ -476,12 +576,9 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 	}
 
 	txLogsOnFirstLevel := []*transaction.ApiLogs{tx.Logs}
-	failed, reason := checkIfFailed(txLogsOnFirstLevel)
-	if failed {
-		return &data.ProcessStatusResponse{
-			Status: string(transaction.TxStatusFail),
-			Reason: reason,
-		}
+	_, failedEvent := findFailureEventInLogs(txLogsOnFirstLevel)
+	if failedEvent != nil {
+		return createFailedStatusFromEvent(failedEvent, tx.Hash)
 	}
 
 	allLogs, err = tp.addMissingLogsOnProcessingExceptions(tx, allLogs, allScrs)
@@ -492,18 +589,14 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 		}
 	}
 
-	failed, reason = checkIfFailed(allLogs)
-	if failed {
-		return &data.ProcessStatusResponse{
-			Status: string(transaction.TxStatusFail),
-			Reason: reason,
-		}
+	failedLog, failedEvent := findFailureEventInLogs(allLogs)
+	if failedEvent != nil {
+		return createFailedStatusFromEvent(failedEvent, findLogsSourceHash(failedLog, tx, allScrs))
 	}
 
-	if checkIfFailedOnReturnMessage(allScrs, tx) {
-		return &data.ProcessStatusResponse{
-			Status: string(transaction.TxStatusFail),
-		}
+	failedResult := findFailedReturnMessage(allScrs, tx)
+	if failedResult != nil {
+		return createFailedStatusFromReturnMessage(failedResult, tx)
 	}
 
 	isUnsigned := string(transaction.TxTypeUnsigned) == tx.Type
@@ -534,10 +627,10 @@ func hasPendingSCR(scrs []*transaction.ApiTransactionResult) bool {
 	return false
 }
 
-func checkIfFailedOnReturnMessage(allScrs []*transaction.ApiTransactionResult, tx *transaction.ApiTransactionResult) bool {
+func findFailedReturnMessage(allScrs []*transaction.ApiTransactionResult, tx *transaction.ApiTransactionResult) *transaction.ApiTransactionResult {
 	hasReturnMessageWithZeroValue := len(tx.ReturnMessage) > 0 && isZeroValue(tx.Value)
 	if hasReturnMessageWithZeroValue && !isRefundScr(tx.ReturnMessage) {
-		return true
+		return tx
 	}
 
 	for _, scr := range allScrs {
@@ -546,11 +639,81 @@ func checkIfFailedOnReturnMessage(allScrs []*transaction.ApiTransactionResult, t
 		}
 
 		if len(scr.ReturnMessage) > 0 && isZeroValue(scr.Value) {
-			return true
+			return scr
 		}
 	}
 
-	return false
+	return nil
+}
+
+func createFailedStatusFromEvent(event *transaction.Events, sourceHash string) *data.ProcessStatusResponse {
+	rule := data.FailureRuleSignalError
+	if event.Identifier == internalVMErrorsEventIdentifier {
+		rule = data.FailureRuleInternalVMErrors
+	}
+
+	return &data.ProcessStatusResponse{
+		Status: string(transaction.TxStatusFail),
+		Reason: string(event.Data),
+		Failure: &data.ProcessStatusFailureDetails{
+			Rule:       rule,
+			SourceHash: sourceHash,
+			Message:    decodeFailureEventMessage(event),
+		},
+	}
+}
+
+func createFailedStatusFromReturnMessage(failedResult *transaction.ApiTransactionResult, tx *transaction.ApiTransactionResult) *data.ProcessStatusResponse {
+	rule := data.FailureRuleReturnMessage
+	if isRelayedTransaction(tx) {
+		rule = data.FailureRuleRelayedTxFailure
+	}
+
+	return &data.ProcessStatusResponse{
+		Status: string(transaction.TxStatusFail),
+		Failure: &data.ProcessStatusFailureDetails{
+			Rule:       rule,
+			SourceHash: failedResult.Hash,
+			Message:    failedResult.ReturnMessage,
+		},
+	}
+}
+
+// decodeFailureEventMessage returns the human-readable error carried by a failure event. A signalError event holds
+// the message in its second topic, while an internalVMErrors event holds the VM error trace as data
+func decodeFailureEventMessage(event *transaction.Events) string {
+	if event.Identifier == core.SignalErrorOperation && len(event.Topics) > 1 {
+		return string(event.Topics[1])
+	}
+
+	message := string(event.Data)
+	if strings.HasPrefix(message, "@") {
+		decoded, err := hex.DecodeString(message[1:])
+		if err == nil {
+			return string(decoded)
+		}
+	}
+
+	return strings.TrimSpace(message)
+}
+
+func findLogsSourceHash(logs *transaction.ApiLogs, tx *transaction.ApiTransactionResult, allScrs []*transaction.ApiTransactionResult) string {
+	for _, scr := range allScrs {
+		if scr.Logs == logs {
+			return scr.Hash
+		}
+	}
+
+	return tx.Hash
+}
+
+func isRelayedTransaction(tx *transaction.ApiTransactionResult) bool {
+	switch tx.ProcessingTypeOnSource {
+	case relayedV1TransactionDescriptor, relayedV2TransactionDescriptor, relayedV3TransactionDescriptor:
+		return true
+	default:
+		return false
+	}
 }
 
 func isRefundScr(returnMessage string) bool {
@@ -564,18 +727,13 @@ func isZeroValue(value string) bool {
 	return value == "0"
 }
 
-func checkIfFailed(logs []*transaction.ApiLogs) (bool, string) {
-	found, reason := findIdentifierInLogs(logs, internalVMErrorsEventIdentifier)
-	if found {
-		return true, reason
-	}
-
-	found, reason = findIdentifierInLogs(logs, core.SignalErrorOperation)
-	if found {
-		return true, reason
+func findFailureEventInLogs(logs []*transaction.ApiLogs) (*transaction.ApiLogs, *transaction.Events) {
+	logInstance, event := findEventInLogs(logs, internalVMErrorsEventIdentifier)
+	if event != nil {
+		return logInstance, event
 	}
 
-	return false, emptyDataStr
+	return findEventInLogs(logs, core.SignalErrorOperation)
 }
 
 func checkIfCompleted(logs []*transaction.ApiLogs) bool {
@@ -676,32 +834,37 @@ func (tp *TransactionProcessor) isRelayedMoveBalanceTransaction(
 }
 
 func findIdentifierInLogs(logs []*transaction.ApiLogs, identifier string) (bool, string) {
-	if len(logs) == 0 {
+	_, event := findEventInLogs(logs, identifier)
+	if event == nil {
 		return false, emptyDataStr
 	}
 
+	return true, string(event.Data)
+}
+
+func findEventInLogs(logs []*transaction.ApiLogs, identifier string) (*transaction.ApiLogs, *transaction.Events) {
 	for _, logInstance := range logs {
 		if logInstance == nil {
 			continue
 		}
 
-		found, reason := findIdentifierInSingleLog(logInstance, identifier)
-		if found {
-			return true, string(reason)
+		event := findEventInSingleLog(logInstance, identifier)
+		if event != nil {
+			return logInstance, event
 		}
 	}
 
-	return false, emptyDataStr
+	return nil, nil
 }
 
-func findIdentifierInSingleLog(log *transaction.ApiLogs, identifier string) (bool, []byte) {
+func findEventInSingleLog(log *transaction.ApiLogs, identifier string) *transaction.Events {
 	for _, event := range log.Events {
 		if event.Identifier == identifier {
-			return true, event.Data
+			return event
 		}
 	}
 
-	return false, []byte(emptyDataStr)
+	return nil
 }
 
 func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
@@ -552,6 +552,170 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 	require.Equal(t, expectedFailReason, respData.Result["receiverShard"].FailReason)
 }
 
+func TestTransactionProcessor_DryRunTransaction(t *testing.T) {
+	t.Parallel()
+
+	txAddressSh0 := []byte("addr in shard 0")
+	txAddressSh1 := []byte("addr in shard 1")
+	createProcessorStub := func(sendCalled *uint32) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				if bytes.Equal(addressBuff, txAddressSh0) {
+					return 0, nil
+				}
+				return 1, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: fmt.Sprintf("offline observer shard %d", shardId), ShardId: shardId},
+					{Address: fmt.Sprintf("observer shard %d", shardId), ShardId: shardId},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				if path != process.TransactionSimulatePath {
+					atomic.AddUint32(sendCalled, 1)
+					return http.StatusOK, nil
+				}
+				if strings.HasPrefix(address, "offline") {
+					return http.StatusNotFound, errors.New("observer offline")
+				}
+
+				resp := response.(*data.ResponseTransactionSimulation)
+				resp.Data.Result.Hash = "hash"
+				resp.Data.Result.Status = transaction.TxStatus(address)
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("invalid fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		sendCalled := uint32(0)
+		tp, _ := process.NewTransactionProcessor(
+			createProcessorStub(&sendCalled),
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+		)
+
+		statusCode, result, err := tp.DryRunTransaction(&data.Transaction{Sender: "not hex", Receiver: hex.EncodeToString(txAddressSh0)})
+		require.Nil(t, result)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Zero(t, atomic.LoadUint32(&sendCalled))
+	})
+	t.Run("intra shard should simulate on the selected observer", func(t *testing.T) {
+		t.Parallel()
+
+		sendCalled := uint32(0)
+		tp, _ := process.NewTransactionProcessor(
+			createProcessorStub(&sendCalled),
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+		)
+
+		tx := &data.Transaction{Sender: hex.EncodeToString(txAddressSh0), Receiver: hex.EncodeToString(txAddressSh0), ChainID: "chain", Version: 1}
+		statusCode, result, err := tp.DryRunTransaction(tx)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, &data.TransactionDryRunResult{
+			TxHash:          "hash",
+			SenderShardID:   0,
+			ReceiverShardID: 0,
+			Observer:        "observer shard 0",
+			SimulationResults: map[string]data.TransactionSimulationResults{
+				"senderShard": {Status: "observer shard 0", Hash: "hash"},
+			},
+		}, result)
+		require.Zero(t, atomic.LoadUint32(&sendCalled))
+	})
+	t.Run("cross shard should simulate on both shards", func(t *testing.T) {
+		t.Parallel()
+
+		sendCalled := uint32(0)
+		tp, _ := process.NewTransactionProcessor(
+			createProcessorStub(&sendCalled),
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+		)
+
+		tx := &data.Transaction{Sender: hex.EncodeToString(txAddressSh0), Receiver: hex.EncodeToString(txAddressSh1), ChainID: "chain", Version: 1}
+		_, result, err := tp.DryRunTransaction(tx)
+		require.Nil(t, err)
+		require.Equal(t, "observer shard 0", result.Observer)
+		require.Equal(t, uint32(1), result.ReceiverShardID)
+		require.Equal(t, "observer shard 0", string(result.SimulationResults["senderShard"].Status))
+		require.Equal(t, "observer shard 1", string(result.SimulationResults["receiverShard"].Status))
+		require.Zero(t, atomic.LoadUint32(&sendCalled))
+	})
+}
+
+func TestTransactionProcessor_DryRunMultipleTransactions(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				require.Equal(t, process.TransactionSimulatePath, path)
+
+				resp := response.(*data.ResponseTransactionSimulation)
+				resp.Data.Result.Hash = hex.EncodeToString(value.(*data.Transaction).Data)
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+	)
+
+	t.Run("no transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := tp.DryRunMultipleTransactions(nil)
+		require.Nil(t, response)
+		require.Equal(t, process.ErrNoValidTransactionToSend, err)
+	})
+	t.Run("should report results and errors by index", func(t *testing.T) {
+		t.Parallel()
+
+		address := hex.EncodeToString([]byte("address"))
+		txs := []*data.Transaction{
+			{Sender: address, Receiver: address, Data: []byte("tx0"), ChainID: "chain", Version: 1},
+			{Sender: "not hex", Receiver: address, ChainID: "chain", Version: 1},
+			{Sender: address, Receiver: address, Data: []byte("tx2"), ChainID: "chain", Version: 1},
+		}
+
+		response, err := tp.DryRunMultipleTransactions(txs)
+		require.Nil(t, err)
+		require.Equal(t, uint64(2), response.NumOfTxs)
+		require.Equal(t, hex.EncodeToString([]byte("tx0")), response.Results[0].TxHash)
+		require.Equal(t, hex.EncodeToString([]byte("tx2")), response.Results[2].TxHash)
+		require.Len(t, response.Errors, 1)
+		require.NotEmpty(t, response.Errors[1])
+	})
+}
+
 func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -2020,6 +2184,76 @@ func TestTransactionProcessor_computeTransactionStatus(t *testing.T) {
 		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
 		require.Equal(t, string(transaction.TxStatusFail), status.Status)
 	})
+	t.Run("failure details", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			file               string
+			expectedRule       data.ProcessStatusFailureRule
+			expectedSourceHash string
+			expectedMessage    string
+		}{
+			{
+				file:               "./testdata/finishedInvalidBuiltinFunction.json",
+				expectedRule:       data.FailureRuleInvalidTransaction,
+				expectedSourceHash: "a4823050d2396540b17bd9290523973763142c9f655bb26cd9e33f359b6d73ad",
+			},
+			{
+				file:               "./testdata/finishedFailedSCR.json",
+				expectedRule:       data.FailureRuleReturnMessage,
+				expectedSourceHash: "7cfde9ad5ead518ec768607a3ac992763f5afdcf31e603fdd56418c7ffe19774",
+				expectedMessage:    "insufficient funds",
+			},
+			{
+				file:               "./testdata/finishedFailedSCDeployWithTransfer.json",
+				expectedRule:       data.FailureRuleSignalError,
+				expectedSourceHash: "SCR-hash1",
+				expectedMessage:    "sending value to non payable contract",
+			},
+			{
+				file:               "./testdata/finishedFailedComplexScenario3.json",
+				expectedRule:       data.FailureRuleInternalVMErrors,
+				expectedSourceHash: "SCR-hash2",
+				expectedMessage: "runtime.go:1172 [error signalled by smartcontract] [callBack]\n" +
+					"\truntime.go:1172 [error signalled by smartcontract] [callBack]\n" +
+					"\truntime.go:1169 [storage decode error: input too short]",
+			},
+			{
+				file:               "./testdata/finishedFailedRelayedTxWithSCCall.json",
+				expectedRule:       data.FailureRuleSignalError,
+				expectedSourceHash: "a4823050d2396540b17bd9290523973763142c9f655bb26cd9e33f359b6d73ad",
+				expectedMessage:    "user error",
+			},
+			{
+				file:               "./testdata/finishedFailedRelayedTxMoveBalanceReturnMessage.json",
+				expectedRule:       data.FailureRuleRelayedTxFailure,
+				expectedSourceHash: "7cfde9ad5ead518ec768607a3ac992763f5afdcf31e603fdd56418c7ffe19774",
+				expectedMessage:    "insufficient funds",
+			},
+		}
+
+		for _, tc := range testCases {
+			testData := loadJsonIntoTxAndScrs(t, tc.file)
+			tp := createTestProcessorFromScenarioData(testData)
+
+			status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+			require.Equal(t, string(transaction.TxStatusFail), status.Status, tc.file)
+			require.NotNil(t, status.Failure, tc.file)
+			require.Equal(t, tc.expectedRule, status.Failure.Rule, tc.file)
+			require.Equal(t, tc.expectedSourceHash, status.Failure.SourceHash, tc.file)
+			require.Equal(t, tc.expectedMessage, status.Failure.Message, tc.file)
+		}
+	})
+	t.Run("successful transaction should not have failure details", func(t *testing.T) {
+		t.Parallel()
+
+		testData := loadJsonIntoTxAndScrs(t, "./testdata/finishedOKSCCall.json")
+		tp := createTestProcessorFromScenarioData(testData)
+
+		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+		require.Equal(t, string(transaction.TxStatusSuccess), status.Status)
+		require.Nil(t, status.Failure)
+	})
 }
 
 func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
@@ -0,0 +1,197 @@
+package process
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const (
+	dnsResolveFunc         = "resolve"
+	defaultUsernameSuffix  = ".elrond"
+	usernamesCacheCapacity = 100000
+
+	resolvedUsernameCacheKeyPrefix = "username_"
+	addressUsernameCacheKeyPrefix  = "address_"
+)
+
+// dnsDeployerAddressFill is the byte the addresses of the DNS contracts' deployers are filled with
+const dnsDeployerAddressFill = 1
+
+type usernameProcessor struct {
+	baseProc        Processor
+	scQueryProc     SCQueryService
+	pubKeyConverter core.PubkeyConverter
+	usernamesCache  ImmutableDataCacheHandler
+}
+
+// NewUsernameProcessor will create a new instance of the username processor, which resolves the usernames through
+// the DNS smart contracts and keeps the results in cache for the provided duration
+func NewUsernameProcessor(
+	baseProc Processor,
+	scQueryProc SCQueryService,
+	pubKeyConverter core.PubkeyConverter,
+	cacheValidityDuration time.Duration,
+) (*usernameProcessor, error) {
+	if check.IfNil(baseProc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if cacheValidityDuration <= 0 {
+		return nil, ErrInvalidCacheValidityDuration
+	}
+
+	usernamesCache, err := cache.NewTTLCache(usernamesCacheCapacity, cacheValidityDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usernameProcessor{
+		baseProc:        baseProc,
+		scQueryProc:     scQueryProc,
+		pubKeyConverter: pubKeyConverter,
+		usernamesCache:  usernamesCache,
+	}, nil
+}
+
+// ResolveUsername returns the address the provided username is registered for, by querying the DNS contract
+// responsible for it. The default suffix is added to the usernames provided without one
+func (up *usernameProcessor) ResolveUsername(username string) (*data.UsernameResolution, error) {
+	username = strings.ToLower(strings.TrimSpace(username))
+	if len(username) == 0 {
+		return nil, ErrInvalidUsername
+	}
+	if !strings.Contains(username, ".") {
+		username += defaultUsernameSuffix
+	}
+
+	cachedResolution, found := up.usernamesCache.Get(resolvedUsernameCacheKeyPrefix + username)
+	if found {
+		return cachedResolution.(*data.UsernameResolution), nil
+	}
+
+	dnsAddress, err := up.computeDNSAddressForUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &data.SCQuery{
+		ScAddress: dnsAddress,
+		FuncName:  dnsResolveFunc,
+		Arguments: [][]byte{[]byte(username)},
+	}
+	vmOutput, _, err := up.scQueryProc.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmOutput.ReturnData) == 0 || len(vmOutput.ReturnData[0]) == 0 {
+		return nil, ErrUsernameNotFound
+	}
+
+	address, err := up.pubKeyConverter.Encode(vmOutput.ReturnData[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resolution := &data.UsernameResolution{
+		Username:   username,
+		Address:    address,
+		DNSAddress: dnsAddress,
+	}
+	up.usernamesCache.Put(resolvedUsernameCacheKeyPrefix+username, resolution)
+
+	return resolution, nil
+}
+
+// GetUsernameForAddress returns the username stored in the account of the provided address. The username is
+// verified by resolving it back through the DNS contract, as it has to point to the same address
+func (up *usernameProcessor) GetUsernameForAddress(address string) (*data.AddressUsername, error) {
+	cachedUsername, found := up.usernamesCache.Get(addressUsernameCacheKeyPrefix + address)
+	if found {
+		return cachedUsername.(*data.AddressUsername), nil
+	}
+
+	account, err := up.getAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	addressUsername := &data.AddressUsername{
+		Address:  address,
+		Username: account.Username,
+	}
+	if len(account.Username) > 0 {
+		resolution, errResolve := up.ResolveUsername(account.Username)
+		if errResolve != nil && !errors.Is(errResolve, ErrUsernameNotFound) {
+			return nil, errResolve
+		}
+
+		addressUsername.IsVerified = errResolve == nil && resolution.Address == address
+	}
+	up.usernamesCache.Put(addressUsernameCacheKeyPrefix+address, addressUsername)
+
+	return addressUsername, nil
+}
+
+func (up *usernameProcessor) getAccount(address string) (*data.Account, error) {
+	addressBytes, err := up.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := up.baseProc.ComputeShardId(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	observers, err := up.baseProc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	response := data.AccountApiResponse{}
+	for _, observer := range observers {
+		_, err = up.baseProc.CallGetRestEndPoint(observer.Address, addressPath+address, &response)
+		if err == nil {
+			return &response.Data.Account, nil
+		}
+
+		log.Error("username account request", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, WrapObserversError(response.Error)
+}
+
+// computeDNSAddressForUsername returns the address of the DNS contract responsible for the provided username. There
+// are 256 DNS contracts, each one deployed by an address filled with the same byte except for its last one, holding
+// the index of the contract, which is given by the last byte of the username's hash
+func (up *usernameProcessor) computeDNSAddressForUsername(username string) (string, error) {
+	usernameHash := keccak.NewKeccak().Compute(username)
+
+	return up.computeDNSAddress(usernameHash[len(usernameHash)-1])
+}
+
+func (up *usernameProcessor) computeDNSAddress(dnsIndex byte) (string, error) {
+	deployer := bytes.Repeat([]byte{dnsDeployerAddressFill}, up.pubKeyConverter.Len())
+	deployer[len(deployer)-core.ShardIdentiferLen] = 0
+	deployer[len(deployer)-1] = dnsIndex
+
+	return up.pubKeyConverter.Encode(computeContractAddress(deployer, 0))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (up *usernameProcessor) IsInterfaceNil() bool {
+	return up == nil
+}
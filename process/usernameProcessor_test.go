@@ -0,0 +1,256 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUsernameProcessor(t *testing.T) {
+	t.Parallel()
+
+	up, err := process.NewUsernameProcessor(nil, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+	require.Nil(t, up)
+	require.Equal(t, process.ErrNilCoreProcessor, err)
+
+	up, err = process.NewUsernameProcessor(&mock.ProcessorStub{}, nil, testPubkeyConverter, time.Minute)
+	require.Nil(t, up)
+	require.Equal(t, process.ErrNilSCQueryService, err)
+
+	up, err = process.NewUsernameProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, nil, time.Minute)
+	require.Nil(t, up)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+
+	up, err = process.NewUsernameProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, 0)
+	require.Nil(t, up)
+	require.Equal(t, process.ErrInvalidCacheValidityDuration, err)
+
+	up, err = process.NewUsernameProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+	require.Nil(t, err)
+	require.False(t, up.IsInterfaceNil())
+}
+
+func TestUsernameProcessor_ComputeDNSAddress(t *testing.T) {
+	t.Parallel()
+
+	up, _ := process.NewUsernameProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+
+	dnsAddress, err := up.ComputeDNSAddress(0)
+	require.Nil(t, err)
+	require.Equal(t, "erd1qqqqqqqqqqqqqpgqnhvsujzd95jz6fyv3ldmynlf97tscs9nqqqq49en6w", dnsAddress)
+
+	dnsAddress, err = up.ComputeDNSAddress(1)
+	require.Nil(t, err)
+	require.Equal(t, "erd1qqqqqqqqqqqqqpgqysmcsfkqed279x6jvs694th4e4v50p4pqqqsxwywm0", dnsAddress)
+}
+
+func TestUsernameProcessor_ResolveUsername(t *testing.T) {
+	t.Parallel()
+
+	aliceBytes, _ := hex.DecodeString(aliceHex)
+
+	t.Run("empty username should error", func(t *testing.T) {
+		t.Parallel()
+
+		up, _ := process.NewUsernameProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+		resolution, err := up.ResolveUsername("  ")
+		require.Nil(t, resolution)
+		require.Equal(t, process.ErrInvalidUsername, err)
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		up, _ := process.NewUsernameProcessor(
+			&mock.ProcessorStub{},
+			&mock.SCQueryServiceStub{
+				ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+					return nil, data.BlockInfo{}, expectedErr
+				},
+			},
+			testPubkeyConverter,
+			time.Minute,
+		)
+
+		resolution, err := up.ResolveUsername("alice")
+		require.Nil(t, resolution)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("unregistered username should error", func(t *testing.T) {
+		t.Parallel()
+
+		up, _ := process.NewUsernameProcessor(
+			&mock.ProcessorStub{},
+			&mock.SCQueryServiceStub{
+				ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+					return &vm.VMOutputApi{ReturnData: [][]byte{{}}}, data.BlockInfo{}, nil
+				},
+			},
+			testPubkeyConverter,
+			time.Minute,
+		)
+
+		resolution, err := up.ResolveUsername("alice")
+		require.Nil(t, resolution)
+		require.Equal(t, process.ErrUsernameNotFound, err)
+	})
+	t.Run("should query the right DNS contract and cache the result", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		var providedQuery *data.SCQuery
+		up, _ := process.NewUsernameProcessor(
+			&mock.ProcessorStub{},
+			&mock.SCQueryServiceStub{
+				ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+					numQueries++
+					providedQuery = query
+					return &vm.VMOutputApi{ReturnData: [][]byte{aliceBytes}}, data.BlockInfo{}, nil
+				},
+			},
+			testPubkeyConverter,
+			time.Minute,
+		)
+
+		resolution, err := up.ResolveUsername("Alice")
+		require.Nil(t, err)
+		require.Equal(t, "alice.elrond", resolution.Username)
+		require.Equal(t, aliceBech32, resolution.Address)
+
+		usernameHash := keccak.NewKeccak().Compute("alice.elrond")
+		expectedDNSAddress, _ := up.ComputeDNSAddress(usernameHash[len(usernameHash)-1])
+		require.Equal(t, expectedDNSAddress, resolution.DNSAddress)
+		require.Equal(t, &data.SCQuery{
+			ScAddress: expectedDNSAddress,
+			FuncName:  "resolve",
+			Arguments: [][]byte{[]byte("alice.elrond")},
+		}, providedQuery)
+
+		cachedResolution, err := up.ResolveUsername("alice.elrond")
+		require.Nil(t, err)
+		require.Equal(t, resolution, cachedResolution)
+		require.Equal(t, 1, numQueries)
+	})
+}
+
+func TestUsernameProcessor_GetUsernameForAddress(t *testing.T) {
+	t.Parallel()
+
+	aliceBytes, _ := hex.DecodeString(aliceHex)
+	createProcessorStub := func(username string) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				response := value.(*data.AccountApiResponse)
+				response.Data.Account.Username = username
+
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("account request error should error", func(t *testing.T) {
+		t.Parallel()
+
+		processorStub := createProcessorStub("")
+		processorStub.CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			return http.StatusInternalServerError, errors.New("offline")
+		}
+		up, _ := process.NewUsernameProcessor(processorStub, &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+
+		addressUsername, err := up.GetUsernameForAddress(aliceBech32)
+		require.Nil(t, addressUsername)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+	})
+	t.Run("account without username should not query the DNS", func(t *testing.T) {
+		t.Parallel()
+
+		up, _ := process.NewUsernameProcessor(createProcessorStub(""), &mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+
+		addressUsername, err := up.GetUsernameForAddress(aliceBech32)
+		require.Nil(t, err)
+		require.Equal(t, &data.AddressUsername{Address: aliceBech32}, addressUsername)
+	})
+	t.Run("username resolving to the same address should be verified", func(t *testing.T) {
+		t.Parallel()
+
+		numAccountRequests := 0
+		processorStub := createProcessorStub("alice.elrond")
+		callGetRestEndPoint := processorStub.CallGetRestEndPointCalled
+		processorStub.CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			numAccountRequests++
+			require.Equal(t, "/address/"+aliceBech32, path)
+			return callGetRestEndPoint(address, path, value)
+		}
+		up, _ := process.NewUsernameProcessor(
+			processorStub,
+			&mock.SCQueryServiceStub{
+				ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+					return &vm.VMOutputApi{ReturnData: [][]byte{aliceBytes}}, data.BlockInfo{}, nil
+				},
+			},
+			testPubkeyConverter,
+			time.Minute,
+		)
+
+		addressUsername, err := up.GetUsernameForAddress(aliceBech32)
+		require.Nil(t, err)
+		require.Equal(t, &data.AddressUsername{Address: aliceBech32, Username: "alice.elrond", IsVerified: true}, addressUsername)
+
+		_, _ = up.GetUsernameForAddress(aliceBech32)
+		require.Equal(t, 1, numAccountRequests)
+	})
+	t.Run("username resolving to another address should not be verified", func(t *testing.T) {
+		t.Parallel()
+
+		otherAddress := []byte(strings.Repeat("b", 32))
+		up, _ := process.NewUsernameProcessor(
+			createProcessorStub("alice.elrond"),
+			&mock.SCQueryServiceStub{
+				ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+					return &vm.VMOutputApi{ReturnData: [][]byte{otherAddress}}, data.BlockInfo{}, nil
+				},
+			},
+			testPubkeyConverter,
+			time.Minute,
+		)
+
+		addressUsername, err := up.GetUsernameForAddress(aliceBech32)
+		require.Nil(t, err)
+		require.Equal(t, "alice.elrond", addressUsername.Username)
+		require.False(t, addressUsername.IsVerified)
+	})
+	t.Run("unregistered username should not be verified", func(t *testing.T) {
+		t.Parallel()
+
+		up, _ := process.NewUsernameProcessor(
+			createProcessorStub("alice.elrond"),
+			&mock.SCQueryServiceStub{
+				ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+					return &vm.VMOutputApi{}, data.BlockInfo{}, nil
+				},
+			},
+			testPubkeyConverter,
+			time.Minute,
+		)
+
+		addressUsername, err := up.GetUsernameForAddress(aliceBech32)
+		require.Nil(t, err)
+		require.False(t, addressUsername.IsVerified)
+	})
+}
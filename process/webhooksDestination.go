@@ -0,0 +1,89 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const webhooksDialTimeout = 10 * time.Second
+
+// nonPublicNetworks holds the non-public IPv4 networks not covered by the checks of net.IP: "this network" of RFC 1122
+// and the shared address space of RFC 6598
+var nonPublicNetworks = []*net.IPNet{
+	{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},
+}
+
+// NewWebhooksHttpClient creates the HTTP client used to deliver the webhook notifications. Unless the private
+// destinations are allowed, it refuses to connect to loopback, link-local, private or otherwise non-public addresses,
+// checked after the host is resolved, so a host resolving to a different address after the registration is still
+// refused. Redirects are not followed, a redirect response being a failed delivery
+func NewWebhooksHttpClient(timeout time.Duration, allowPrivateDestinations bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhooksDialTimeout,
+	}
+	if !allowPrivateDestinations {
+		dialer.Control = checkWebhookDialAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func checkWebhookDialAddress(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%w: %s", ErrWebhookDestinationNotAllowed, host)
+	}
+
+	return checkWebhookDestinationIP(ip)
+}
+
+// checkWebhookDestination resolves the provided host and checks that all its addresses are public
+func checkWebhookDestination(ctx context.Context, host string) error {
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = checkWebhookDestinationIP(address.IP)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkWebhookDestinationIP(ip net.IP) error {
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return fmt.Errorf("%w: %s", ErrWebhookDestinationNotAllowed, ip.String())
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return fmt.Errorf("%w: %s", ErrWebhookDestinationNotAllowed, ip.String())
+		}
+	}
+
+	return nil
+}
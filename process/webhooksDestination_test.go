@@ -0,0 +1,49 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhooksHttpClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("private destinations should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Fail(t, "the connection should be refused")
+		}))
+		defer receiver.Close()
+
+		client := process.NewWebhooksHttpClient(time.Second, false)
+		resp, err := client.Post(receiver.URL, "application/json", nil)
+		require.Nil(t, resp)
+		require.True(t, errors.Is(err, process.ErrWebhookDestinationNotAllowed))
+	})
+	t.Run("redirects should not be followed", func(t *testing.T) {
+		t.Parallel()
+
+		redirectTarget := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Fail(t, "the redirect should not be followed")
+		}))
+		defer redirectTarget.Close()
+
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, redirectTarget.URL, http.StatusTemporaryRedirect)
+		}))
+		defer receiver.Close()
+
+		client := process.NewWebhooksHttpClient(time.Second, true)
+		resp, err := client.Post(receiver.URL, "application/json", nil)
+		require.Nil(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	})
+}
@@ -0,0 +1,520 @@
+package process
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// WebhookSignatureHeader is the header holding the HMAC-SHA256 signature of a notification, computed over the
+	// request body with the secret of the subscription
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookSubscriptionHeader is the header holding the ID of the notified subscription
+	WebhookSubscriptionHeader = "X-Webhook-Subscription"
+
+	webhookSignaturePrefix      = "sha256="
+	webhookIDLength             = 16
+	webhookSecretLength         = 32
+	webhooksFollowRetryInterval = 5 * time.Second
+)
+
+// ArgWebhooksProcessor is the DTO used to create a new instance of webhooksProcessor
+type ArgWebhooksProcessor struct {
+	HyperblocksFollower      HyperblocksFollowerHandler
+	Store                    WebhooksStore
+	HttpClient               HttpClient
+	PubKeyConverter          core.PubkeyConverter
+	MaxSubscriptions         int
+	MaxRetries               uint32
+	InitialBackoff           time.Duration
+	MaxBackoff               time.Duration
+	DeliveryLogSize          int
+	AllowPrivateDestinations bool
+}
+
+type webhooksProcessor struct {
+	hyperblocksFollower      HyperblocksFollowerHandler
+	store                    WebhooksStore
+	httpClient               HttpClient
+	pubKeyConverter          core.PubkeyConverter
+	allowPrivateDestinations bool
+	maxSubscriptions         int
+	maxRetries               uint32
+	initialBackoff           time.Duration
+	maxBackoff               time.Duration
+	deliveryLogSize          int
+
+	mutSubscriptions sync.Mutex
+	ctx              context.Context
+	cancelFunc       func()
+	workers          map[string]func()
+
+	mutDeliveries sync.RWMutex
+	deliveries    map[string][]*data.WebhookDelivery
+}
+
+// NewWebhooksProcessor will create a new instance of the webhooks processor, which follows the hyperblocks and posts
+// the transactions matching the filter of each subscription to its URL, retrying with exponential backoff. Each
+// subscription is notified on its own, from its own latest notified hyperblock, so a slow or unreachable webhook does
+// not hold back the others
+func NewWebhooksProcessor(args ArgWebhooksProcessor) (*webhooksProcessor, error) {
+	err := checkWebhooksProcessorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhooksProcessor{
+		hyperblocksFollower:      args.HyperblocksFollower,
+		store:                    args.Store,
+		httpClient:               args.HttpClient,
+		pubKeyConverter:          args.PubKeyConverter,
+		allowPrivateDestinations: args.AllowPrivateDestinations,
+		maxSubscriptions:         args.MaxSubscriptions,
+		maxRetries:               args.MaxRetries,
+		initialBackoff:           args.InitialBackoff,
+		maxBackoff:               args.MaxBackoff,
+		deliveryLogSize:          args.DeliveryLogSize,
+		workers:                  make(map[string]func()),
+		deliveries:               make(map[string][]*data.WebhookDelivery),
+	}, nil
+}
+
+func checkWebhooksProcessorArgs(args ArgWebhooksProcessor) error {
+	if check.IfNil(args.HyperblocksFollower) {
+		return ErrNilHyperblocksFollower
+	}
+	if check.IfNil(args.Store) {
+		return ErrNilWebhooksStore
+	}
+	if check.IfNilReflect(args.HttpClient) {
+		return ErrNilHttpClient
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if args.MaxSubscriptions <= 0 {
+		return fmt.Errorf("%w for MaxSubscriptions, %d provided", core.ErrInvalidValue, args.MaxSubscriptions)
+	}
+	if args.InitialBackoff <= 0 {
+		return fmt.Errorf("%w for InitialBackoff, %v provided", core.ErrInvalidValue, args.InitialBackoff)
+	}
+	if args.MaxBackoff < args.InitialBackoff {
+		return fmt.Errorf("%w for MaxBackoff, %v provided", core.ErrInvalidValue, args.MaxBackoff)
+	}
+	if args.DeliveryLogSize <= 0 {
+		return fmt.Errorf("%w for DeliveryLogSize, %d provided", core.ErrInvalidValue, args.DeliveryLogSize)
+	}
+
+	return nil
+}
+
+// RegisterWebhook validates and persists a new subscription of the provided owner. The returned subscription is the
+// only one holding the secret
+func (wp *webhooksProcessor) RegisterWebhook(owner string, request data.WebhookSubscriptionRequest) (*data.WebhookSubscription, error) {
+	err := wp.checkSubscriptionRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	wp.mutSubscriptions.Lock()
+	defer wp.mutSubscriptions.Unlock()
+
+	if len(wp.store.GetAll()) >= wp.maxSubscriptions {
+		return nil, ErrTooManyWebhookSubscriptions
+	}
+
+	id, err := generateRandomHex(webhookIDLength)
+	if err != nil {
+		return nil, err
+	}
+	secret := request.Secret
+	if len(secret) == 0 {
+		secret, err = generateRandomHex(webhookSecretLength)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subscription := &data.WebhookSubscription{
+		ID:        id,
+		Owner:     owner,
+		URL:       request.URL,
+		Secret:    secret,
+		Filter:    request.Filter,
+		CreatedAt: time.Now().Unix(),
+	}
+	err = wp.store.Add(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	if wp.ctx != nil {
+		wp.startWorker(subscription)
+	}
+
+	log.Debug("webhook registered", "id", id, "owner", owner, "url", request.URL)
+
+	subscriptionCopy := *subscription
+	return &subscriptionCopy, nil
+}
+
+func (wp *webhooksProcessor) checkSubscriptionRequest(request data.WebhookSubscriptionRequest) error {
+	parsedURL, err := url.Parse(request.URL)
+	if err != nil || len(parsedURL.Host) == 0 || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return fmt.Errorf("%w: invalid URL %s", ErrInvalidWebhookSubscription, request.URL)
+	}
+	if !wp.allowPrivateDestinations {
+		ctx, cancel := context.WithTimeout(context.Background(), webhooksDialTimeout)
+		defer cancel()
+
+		err = checkWebhookDestination(ctx, parsedURL.Hostname())
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidWebhookSubscription, err)
+		}
+	}
+	if request.Filter.IsEmpty() {
+		return fmt.Errorf("%w: empty filter", ErrInvalidWebhookSubscription)
+	}
+
+	for _, address := range request.Filter.Addresses {
+		_, err = wp.pubKeyConverter.Decode(address)
+		if err != nil {
+			return fmt.Errorf("%w: invalid address %s", ErrInvalidWebhookSubscription, address)
+		}
+	}
+
+	return nil
+}
+
+// GetWebhooks returns the subscriptions of the provided owner, without their secrets
+func (wp *webhooksProcessor) GetWebhooks(owner string) []*data.WebhookSubscription {
+	subscriptions := make([]*data.WebhookSubscription, 0)
+	for _, subscription := range wp.store.GetAll() {
+		if subscription.Owner != owner {
+			continue
+		}
+
+		subscriptionCopy := *subscription
+		subscriptionCopy.Secret = ""
+		subscriptions = append(subscriptions, &subscriptionCopy)
+	}
+
+	return subscriptions
+}
+
+// RemoveWebhook removes a subscription of the provided owner, along with its delivery log, and stops notifying it
+func (wp *webhooksProcessor) RemoveWebhook(owner string, id string) error {
+	wp.mutSubscriptions.Lock()
+	defer wp.mutSubscriptions.Unlock()
+
+	_, err := wp.getSubscription(owner, id)
+	if err != nil {
+		return err
+	}
+
+	err = wp.store.Remove(id)
+	if err != nil {
+		return err
+	}
+
+	stopWorker, found := wp.workers[id]
+	if found {
+		stopWorker()
+		delete(wp.workers, id)
+	}
+
+	wp.mutDeliveries.Lock()
+	delete(wp.deliveries, id)
+	wp.mutDeliveries.Unlock()
+
+	log.Debug("webhook removed", "id", id, "owner", owner)
+
+	return nil
+}
+
+// GetWebhookDeliveries returns the latest deliveries of a subscription of the provided owner, the most recent last
+func (wp *webhooksProcessor) GetWebhookDeliveries(owner string, id string) ([]*data.WebhookDelivery, error) {
+	_, err := wp.getSubscription(owner, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wp.mutDeliveries.RLock()
+	defer wp.mutDeliveries.RUnlock()
+
+	deliveries := make([]*data.WebhookDelivery, len(wp.deliveries[id]))
+	copy(deliveries, wp.deliveries[id])
+
+	return deliveries, nil
+}
+
+func (wp *webhooksProcessor) getSubscription(owner string, id string) (*data.WebhookSubscription, error) {
+	for _, subscription := range wp.store.GetAll() {
+		if subscription.ID == id && subscription.Owner == owner {
+			return subscription, nil
+		}
+	}
+
+	return nil, ErrWebhookSubscriptionNotFound
+}
+
+// StartNotifications starts notifying each subscription, from the hyperblock after its latest notified one or, if it
+// has not been notified yet, from the latest one handed by the hyperblocks follower
+func (wp *webhooksProcessor) StartNotifications() {
+	wp.mutSubscriptions.Lock()
+	defer wp.mutSubscriptions.Unlock()
+
+	if wp.ctx != nil {
+		log.Error("webhooksProcessor - notifications already started")
+		return
+	}
+
+	wp.ctx, wp.cancelFunc = context.WithCancel(context.Background())
+	for _, subscription := range wp.store.GetAll() {
+		wp.startWorker(subscription)
+	}
+}
+
+// startWorker starts notifying the provided subscription. It must be called under the subscriptions mutex
+func (wp *webhooksProcessor) startWorker(subscription *data.WebhookSubscription) {
+	ctx, cancel := context.WithCancel(wp.ctx)
+	wp.workers[subscription.ID] = cancel
+
+	go wp.followHyperblocks(ctx, subscription)
+}
+
+func (wp *webhooksProcessor) followHyperblocks(ctx context.Context, subscription *data.WebhookSubscription) {
+	options := common.HyperblockQueryOptions{
+		WithLogs: true,
+	}
+
+	for {
+		fromNonce := wp.store.GetLastNonce(subscription.ID)
+		if fromNonce.HasValue {
+			fromNonce.Value++
+		}
+
+		err := wp.hyperblocksFollower.FollowHyperBlocks(ctx, fromNonce, options, func(hyperblock *api.Hyperblock) error {
+			return wp.notifyHyperblock(ctx, subscription, hyperblock)
+		})
+		if ctx.Err() != nil {
+			log.Debug("finishing webhook notifications...", "id", subscription.ID)
+			return
+		}
+
+		log.Warn("webhooks: cannot follow the hyperblocks", "id", subscription.ID, "error", err)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("finishing webhook notifications...", "id", subscription.ID)
+			return
+		case <-time.After(webhooksFollowRetryInterval):
+		}
+	}
+}
+
+// notifyHyperblock posts the matching transactions of the provided hyperblock to the subscription, if any, and records
+// the hyperblock as notified to it once the delivery is done, either successful or not
+func (wp *webhooksProcessor) notifyHyperblock(ctx context.Context, subscription *data.WebhookSubscription, hyperblock *api.Hyperblock) error {
+	notification := wp.createNotification(subscription, hyperblock)
+	if len(notification.Transactions) > 0 {
+		delivery := wp.deliver(ctx, subscription, notification)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		wp.addDelivery(delivery)
+	}
+
+	return wp.store.SetLastNonce(subscription.ID, hyperblock.Nonce)
+}
+
+func (wp *webhooksProcessor) createNotification(subscription *data.WebhookSubscription, hyperblock *api.Hyperblock) *data.WebhookNotification {
+	filter := newHyperblockFilter(common.HyperblockFilters{
+		Addresses: subscription.Filter.Addresses,
+		Tokens:    subscription.Filter.Tokens,
+		Functions: subscription.Filter.Functions,
+	})
+	filteredHyperblock := filter.apply(*hyperblock)
+
+	identifiers := toSet(subscription.Filter.Identifiers)
+	matchingHyperblock := filteredHyperblock
+	matchingHyperblock.Transactions = make([]*transaction.ApiTransactionResult, 0, len(filteredHyperblock.Transactions))
+	for _, tx := range filteredHyperblock.Transactions {
+		if len(identifiers) == 0 || hasEventWithIdentifier(tx, identifiers) {
+			matchingHyperblock.Transactions = append(matchingHyperblock.Transactions, tx)
+		}
+	}
+
+	events := make([]*data.FoundEvent, 0)
+	for _, foundEvent := range findEvents(&matchingHyperblock, &eventsMatcher{}, wp.pubKeyConverter) {
+		_, found := identifiers[foundEvent.Event.Identifier]
+		if len(identifiers) == 0 || found {
+			events = append(events, foundEvent)
+		}
+	}
+
+	return &data.WebhookNotification{
+		SubscriptionID:  subscription.ID,
+		HyperblockNonce: hyperblock.Nonce,
+		HyperblockHash:  hyperblock.Hash,
+		Transactions:    matchingHyperblock.Transactions,
+		Events:          events,
+	}
+}
+
+func hasEventWithIdentifier(tx *transaction.ApiTransactionResult, identifiers map[string]struct{}) bool {
+	if tx.Logs == nil {
+		return false
+	}
+
+	for _, event := range tx.Logs.Events {
+		if event == nil {
+			continue
+		}
+
+		_, found := identifiers[event.Identifier]
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deliver posts the notification until it is accepted with a 2xx status code, at most 1 + maxRetries times, waiting
+// between attempts for an exponentially growing duration, capped by maxBackoff
+func (wp *webhooksProcessor) deliver(ctx context.Context, subscription *data.WebhookSubscription, notification *data.WebhookNotification) *data.WebhookDelivery {
+	delivery := &data.WebhookDelivery{
+		SubscriptionID:  subscription.ID,
+		HyperblockNonce: notification.HyperblockNonce,
+		HyperblockHash:  notification.HyperblockHash,
+		NumTxs:          len(notification.Transactions),
+	}
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		delivery.Error = err.Error()
+		delivery.Timestamp = time.Now().Unix()
+		return delivery
+	}
+
+	backoff := wp.initialBackoff
+	for {
+		delivery.Attempts++
+		delivery.StatusCode, err = wp.post(ctx, subscription, body)
+		delivery.Timestamp = time.Now().Unix()
+		if err == nil {
+			delivery.Delivered = true
+			delivery.Error = ""
+			return delivery
+		}
+
+		delivery.Error = err.Error()
+		if delivery.Attempts > wp.maxRetries {
+			log.Debug("webhook delivery failed", "id", subscription.ID, "nonce", notification.HyperblockNonce, "error", err)
+			return delivery
+		}
+
+		select {
+		case <-ctx.Done():
+			return delivery
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > wp.maxBackoff {
+			backoff = wp.maxBackoff
+		}
+	}
+}
+
+func (wp *webhooksProcessor) post(ctx context.Context, subscription *data.WebhookSubscription, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSubscriptionHeader, subscription.ID)
+	req.Header.Set(WebhookSignatureHeader, webhookSignaturePrefix+computeWebhookSignature(subscription.Secret, body))
+
+	resp, err := wp.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func computeWebhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (wp *webhooksProcessor) addDelivery(delivery *data.WebhookDelivery) {
+	wp.mutDeliveries.Lock()
+	defer wp.mutDeliveries.Unlock()
+
+	deliveries := append(wp.deliveries[delivery.SubscriptionID], delivery)
+	if len(deliveries) > wp.deliveryLogSize {
+		deliveries = deliveries[len(deliveries)-wp.deliveryLogSize:]
+	}
+
+	wp.deliveries[delivery.SubscriptionID] = deliveries
+}
+
+func generateRandomHex(numBytes int) (string, error) {
+	buff := make([]byte, numBytes)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buff), nil
+}
+
+// Close stops notifying the subscriptions
+func (wp *webhooksProcessor) Close() error {
+	wp.mutSubscriptions.Lock()
+	defer wp.mutSubscriptions.Unlock()
+
+	if wp.cancelFunc != nil {
+		wp.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wp *webhooksProcessor) IsInterfaceNil() bool {
+	return wp == nil
+}
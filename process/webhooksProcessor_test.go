@@ -0,0 +1,486 @@
+package process_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgWebhooksProcessor(t *testing.T) process.ArgWebhooksProcessor {
+	store, err := process.NewWebhooksStore(filepath.Join(t.TempDir(), "store.json"))
+	require.Nil(t, err)
+
+	// the test receivers listen on the loopback interface
+	return process.ArgWebhooksProcessor{
+		HyperblocksFollower:      &mock.HyperblocksFollowerStub{},
+		Store:                    store,
+		HttpClient:               &http.Client{},
+		PubKeyConverter:          testPubkeyConverter,
+		MaxSubscriptions:         10,
+		MaxRetries:               2,
+		InitialBackoff:           time.Millisecond,
+		MaxBackoff:               time.Millisecond * 2,
+		DeliveryLogSize:          10,
+		AllowPrivateDestinations: true,
+	}
+}
+
+func TestNewWebhooksProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblocks follower should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.HyperblocksFollower = nil
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.Equal(t, process.ErrNilHyperblocksFollower, err)
+	})
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.Store = nil
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.Equal(t, process.ErrNilWebhooksStore, err)
+	})
+	t.Run("nil http client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.HttpClient = nil
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.Equal(t, process.ErrNilHttpClient, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.PubKeyConverter = nil
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid max subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.MaxSubscriptions = 0
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "MaxSubscriptions"))
+	})
+	t.Run("max backoff lower than the initial one should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.MaxBackoff = args.InitialBackoff / 2
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "MaxBackoff"))
+	})
+	t.Run("invalid delivery log size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.DeliveryLogSize = 0
+		wp, err := process.NewWebhooksProcessor(args)
+		require.True(t, check.IfNil(wp))
+		require.True(t, errors.Is(err, core.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "DeliveryLogSize"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wp, err := process.NewWebhooksProcessor(createMockArgWebhooksProcessor(t))
+		require.False(t, check.IfNil(wp))
+		require.Nil(t, err)
+	})
+}
+
+func TestWebhooksProcessor_RegisterWebhook(t *testing.T) {
+	t.Parallel()
+
+	address, _ := testPubkeyConverter.Encode(bytes.Repeat([]byte{1}, 32))
+
+	t.Run("invalid requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		wp, _ := process.NewWebhooksProcessor(createMockArgWebhooksProcessor(t))
+		invalidRequests := []data.WebhookSubscriptionRequest{
+			{URL: "", Filter: data.WebhookFilter{Tokens: []string{"TKN-abcdef"}}},
+			{URL: "ftp://localhost/hook", Filter: data.WebhookFilter{Tokens: []string{"TKN-abcdef"}}},
+			{URL: "http://localhost/hook"},
+			{URL: "http://localhost/hook", Filter: data.WebhookFilter{Addresses: []string{"invalid"}}},
+		}
+		for _, request := range invalidRequests {
+			subscription, err := wp.RegisterWebhook("alice", request)
+			require.Nil(t, subscription)
+			require.True(t, errors.Is(err, process.ErrInvalidWebhookSubscription))
+		}
+	})
+	t.Run("non-public destinations should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.AllowPrivateDestinations = false
+		wp, _ := process.NewWebhooksProcessor(args)
+		filter := data.WebhookFilter{Tokens: []string{"TKN-abcdef"}}
+		destinations := []string{
+			"http://localhost/hook",
+			"http://127.0.0.1:8080/hook",
+			"http://169.254.169.254/latest/meta-data",
+			"http://10.0.0.1/hook",
+			"https://192.168.1.1/hook",
+			"http://[::1]/hook",
+			"http://[fd00::1]/hook",
+			"http://0.0.0.0/hook",
+		}
+		for _, destination := range destinations {
+			subscription, err := wp.RegisterWebhook("alice", data.WebhookSubscriptionRequest{URL: destination, Filter: filter})
+			require.Nil(t, subscription)
+			require.True(t, errors.Is(err, process.ErrInvalidWebhookSubscription), destination)
+			require.True(t, strings.Contains(err.Error(), process.ErrWebhookDestinationNotAllowed.Error()), destination)
+		}
+
+		subscription, err := wp.RegisterWebhook("alice", data.WebhookSubscriptionRequest{URL: "https://1.1.1.1/hook", Filter: filter})
+		require.Nil(t, err)
+		require.NotNil(t, subscription)
+	})
+	t.Run("too many subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgWebhooksProcessor(t)
+		args.MaxSubscriptions = 1
+		wp, _ := process.NewWebhooksProcessor(args)
+		request := data.WebhookSubscriptionRequest{URL: "http://localhost/hook", Filter: data.WebhookFilter{Tokens: []string{"TKN-abcdef"}}}
+
+		_, err := wp.RegisterWebhook("alice", request)
+		require.Nil(t, err)
+		_, err = wp.RegisterWebhook("bob", request)
+		require.Equal(t, process.ErrTooManyWebhookSubscriptions, err)
+	})
+	t.Run("should work and be scoped by owner", func(t *testing.T) {
+		t.Parallel()
+
+		wp, _ := process.NewWebhooksProcessor(createMockArgWebhooksProcessor(t))
+		request := data.WebhookSubscriptionRequest{URL: "https://localhost/hook", Filter: data.WebhookFilter{Addresses: []string{address}}}
+
+		subscription, err := wp.RegisterWebhook("alice", request)
+		require.Nil(t, err)
+		require.Equal(t, "alice", subscription.Owner)
+		require.Len(t, subscription.ID, 32)
+		require.Len(t, subscription.Secret, 64)
+
+		request.Secret = "my secret"
+		bobSubscription, err := wp.RegisterWebhook("bob", request)
+		require.Nil(t, err)
+		require.Equal(t, "my secret", bobSubscription.Secret)
+
+		subscriptions := wp.GetWebhooks("alice")
+		require.Len(t, subscriptions, 1)
+		require.Equal(t, subscription.ID, subscriptions[0].ID)
+		require.Empty(t, subscriptions[0].Secret)
+
+		require.Equal(t, process.ErrWebhookSubscriptionNotFound, wp.RemoveWebhook("bob", subscription.ID))
+		_, err = wp.GetWebhookDeliveries("bob", subscription.ID)
+		require.Equal(t, process.ErrWebhookSubscriptionNotFound, err)
+
+		require.Nil(t, wp.RemoveWebhook("alice", subscription.ID))
+		require.Empty(t, wp.GetWebhooks("alice"))
+		require.Len(t, wp.GetWebhooks("bob"), 1)
+	})
+}
+
+func TestWebhooksProcessor_ShouldNotifyMatchingTransactions(t *testing.T) {
+	t.Parallel()
+
+	address, _ := testPubkeyConverter.Encode(bytes.Repeat([]byte{1}, 32))
+	otherAddress, _ := testPubkeyConverter.Encode(bytes.Repeat([]byte{2}, 32))
+
+	var numRequests uint32
+	mutNotifications := sync.Mutex{}
+	notifications := make([]*data.WebhookNotification, 0)
+	signatures := make([]string, 0)
+	bodies := make([][]byte, 0)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first attempt fails, so the delivery has to be retried
+		if atomic.AddUint32(&numRequests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		notification := &data.WebhookNotification{}
+		_ = json.Unmarshal(body, notification)
+
+		mutNotifications.Lock()
+		notifications = append(notifications, notification)
+		signatures = append(signatures, r.Header.Get(process.WebhookSignatureHeader))
+		bodies = append(bodies, body)
+		mutNotifications.Unlock()
+	}))
+	defer receiver.Close()
+
+	followedNonce := make(chan core.OptionalUint64, 1)
+	args := createMockArgWebhooksProcessor(t)
+	args.HyperblocksFollower = &mock.HyperblocksFollowerStub{
+		FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			require.True(t, options.WithLogs)
+			followedNonce <- fromNonce
+
+			err := handler(&api.Hyperblock{
+				Nonce: 5,
+				Hash:  "hash5",
+				Transactions: []*transaction.ApiTransactionResult{
+					{
+						Hash:     "tx1",
+						Sender:   address,
+						Receiver: otherAddress,
+						Logs: &transaction.ApiLogs{
+							Events: []*transaction.Events{
+								{Address: address, Identifier: "transferValueOnly"},
+								{Address: address, Identifier: "writeLog"},
+							},
+						},
+					},
+					{Hash: "tx2", Sender: otherAddress, Receiver: otherAddress},
+				},
+			})
+			require.Nil(t, err)
+
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	wp, _ := process.NewWebhooksProcessor(args)
+
+	subscription, err := wp.RegisterWebhook("alice", data.WebhookSubscriptionRequest{
+		URL: receiver.URL,
+		Filter: data.WebhookFilter{
+			Addresses:   []string{address},
+			Identifiers: []string{"transferValueOnly"},
+		},
+	})
+	require.Nil(t, err)
+
+	wp.StartNotifications()
+	defer func() {
+		_ = wp.Close()
+	}()
+
+	require.False(t, (<-followedNonce).HasValue)
+	require.Eventually(t, func() bool {
+		lastNonce := args.Store.GetLastNonce(subscription.ID)
+		return lastNonce.HasValue && lastNonce.Value == 5
+	}, time.Second*5, time.Millisecond*10)
+
+	mutNotifications.Lock()
+	require.Len(t, notifications, 1)
+	require.Equal(t, subscription.ID, notifications[0].SubscriptionID)
+	require.Equal(t, uint64(5), notifications[0].HyperblockNonce)
+	require.Len(t, notifications[0].Transactions, 1)
+	require.Equal(t, "tx1", notifications[0].Transactions[0].Hash)
+	require.Len(t, notifications[0].Events, 1)
+	require.Equal(t, "transferValueOnly", notifications[0].Events[0].Event.Identifier)
+
+	mac := hmac.New(sha256.New, []byte(subscription.Secret))
+	_, _ = mac.Write(bodies[0])
+	require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signatures[0])
+	mutNotifications.Unlock()
+
+	deliveries, err := wp.GetWebhookDeliveries("alice", subscription.ID)
+	require.Nil(t, err)
+	require.Len(t, deliveries, 1)
+	require.True(t, deliveries[0].Delivered)
+	require.Equal(t, uint32(2), deliveries[0].Attempts)
+	require.Equal(t, http.StatusOK, deliveries[0].StatusCode)
+	require.Equal(t, 1, deliveries[0].NumTxs)
+}
+
+func TestWebhooksProcessor_ShouldRecordFailedDeliveries(t *testing.T) {
+	t.Parallel()
+
+	var numRequests uint32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(&numRequests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	args := createMockArgWebhooksProcessor(t)
+	followedNonce := make(chan core.OptionalUint64, 1)
+	args.HyperblocksFollower = &mock.HyperblocksFollowerStub{
+		FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			followedNonce <- fromNonce
+
+			_ = handler(&api.Hyperblock{
+				Nonce: 10,
+				Transactions: []*transaction.ApiTransactionResult{
+					{Hash: "tx1", Function: "claim"},
+				},
+			})
+
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	wp, _ := process.NewWebhooksProcessor(args)
+
+	subscription, _ := wp.RegisterWebhook("alice", data.WebhookSubscriptionRequest{
+		URL:    receiver.URL,
+		Filter: data.WebhookFilter{Functions: []string{"claim"}},
+	})
+	require.Nil(t, args.Store.SetLastNonce(subscription.ID, 9))
+
+	wp.StartNotifications()
+	defer func() {
+		_ = wp.Close()
+	}()
+
+	fromNonce := <-followedNonce
+	require.True(t, fromNonce.HasValue)
+	require.Equal(t, uint64(10), fromNonce.Value)
+	require.Eventually(t, func() bool {
+		return args.Store.GetLastNonce(subscription.ID).Value == 10
+	}, time.Second*5, time.Millisecond*10)
+
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numRequests))
+	deliveries, _ := wp.GetWebhookDeliveries("alice", subscription.ID)
+	require.Len(t, deliveries, 1)
+	require.False(t, deliveries[0].Delivered)
+	require.Equal(t, uint32(3), deliveries[0].Attempts)
+	require.Equal(t, http.StatusInternalServerError, deliveries[0].StatusCode)
+	require.NotEmpty(t, deliveries[0].Error)
+}
+
+func TestWebhooksProcessor_UnreachableWebhookShouldNotHoldBackTheOthers(t *testing.T) {
+	t.Parallel()
+
+	stuckReceiverDone := make(chan struct{})
+	stuckReceiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-stuckReceiverDone:
+		case <-r.Context().Done():
+		}
+	}))
+	defer func() {
+		close(stuckReceiverDone)
+		stuckReceiver.Close()
+	}()
+
+	var numNotifications uint32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(&numNotifications, 1)
+	}))
+	defer receiver.Close()
+
+	args := createMockArgWebhooksProcessor(t)
+	args.HyperblocksFollower = &mock.HyperblocksFollowerStub{
+		FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			for nonce := uint64(1); nonce <= 3; nonce++ {
+				err := handler(&api.Hyperblock{
+					Nonce:        nonce,
+					Transactions: []*transaction.ApiTransactionResult{{Hash: "tx", Function: "claim"}},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	wp, _ := process.NewWebhooksProcessor(args)
+
+	filter := data.WebhookFilter{Functions: []string{"claim"}}
+	stuckSubscription, _ := wp.RegisterWebhook("alice", data.WebhookSubscriptionRequest{URL: stuckReceiver.URL, Filter: filter})
+	subscription, _ := wp.RegisterWebhook("bob", data.WebhookSubscriptionRequest{URL: receiver.URL, Filter: filter})
+
+	wp.StartNotifications()
+	defer func() {
+		_ = wp.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return args.Store.GetLastNonce(subscription.ID).Value == 3
+	}, time.Second*5, time.Millisecond*10)
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numNotifications))
+	require.False(t, args.Store.GetLastNonce(stuckSubscription.ID).HasValue)
+
+	// removing the stuck webhook should stop its pending delivery
+	require.Nil(t, wp.RemoveWebhook("alice", stuckSubscription.ID))
+	require.Eventually(t, func() bool {
+		deliveries, _ := wp.GetWebhookDeliveries("bob", subscription.ID)
+		return len(deliveries) == 3
+	}, time.Second*5, time.Millisecond*10)
+	require.False(t, args.Store.GetLastNonce(stuckSubscription.ID).HasValue)
+}
+
+func TestWebhooksProcessor_RegisteredWebhookShouldBeNotifiedAfterStart(t *testing.T) {
+	t.Parallel()
+
+	var numNotifications uint32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(&numNotifications, 1)
+	}))
+	defer receiver.Close()
+
+	args := createMockArgWebhooksProcessor(t)
+	args.HyperblocksFollower = &mock.HyperblocksFollowerStub{
+		FollowHyperBlocksCalled: func(ctx context.Context, fromNonce core.OptionalUint64, options common.HyperblockQueryOptions, handler func(hyperblock *api.Hyperblock) error) error {
+			_ = handler(&api.Hyperblock{
+				Nonce:        7,
+				Transactions: []*transaction.ApiTransactionResult{{Hash: "tx", Function: "claim"}},
+			})
+
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	wp, _ := process.NewWebhooksProcessor(args)
+	wp.StartNotifications()
+	defer func() {
+		_ = wp.Close()
+	}()
+
+	subscription, err := wp.RegisterWebhook("alice", data.WebhookSubscriptionRequest{
+		URL:    receiver.URL,
+		Filter: data.WebhookFilter{Functions: []string{"claim"}},
+	})
+	require.Nil(t, err)
+
+	require.Eventually(t, func() bool {
+		return args.Store.GetLastNonce(subscription.ID).Value == 7
+	}, time.Second*5, time.Millisecond*10)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numNotifications))
+}
@@ -0,0 +1,206 @@
+package process
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// webhooksStoreContent is the content of the webhooks store file
+type webhooksStoreContent struct {
+	Subscriptions []*data.WebhookSubscription `json:"subscriptions"`
+	LastNonces    map[string]uint64           `json:"lastNonces,omitempty"`
+}
+
+type webhooksStore struct {
+	path          string
+	mut           sync.RWMutex
+	subscriptions []*data.WebhookSubscription
+	lastNonces    map[string]uint64
+}
+
+// NewWebhooksStore will create a new instance of the webhooks store, which keeps the webhook subscriptions and, for
+// each of them, the nonce of the latest notified hyperblock in a JSON file, so they survive restarts. The content of an
+// existing file is loaded
+func NewWebhooksStore(path string) (*webhooksStore, error) {
+	if len(path) == 0 {
+		return nil, ErrInvalidWebhooksStorePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &webhooksStore{
+		path:          path,
+		subscriptions: make([]*data.WebhookSubscription, 0),
+		lastNonces:    make(map[string]uint64),
+	}
+
+	err = store.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (store *webhooksStore) load() error {
+	buff, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	content := &webhooksStoreContent{}
+	err = json.Unmarshal(buff, content)
+	if err != nil {
+		return err
+	}
+
+	if content.Subscriptions != nil {
+		store.subscriptions = content.Subscriptions
+	}
+	if content.LastNonces != nil {
+		store.lastNonces = content.LastNonces
+	}
+
+	return nil
+}
+
+// save writes the content of the store to a temporary file which then replaces the store file, so a crash while
+// writing does not corrupt it. It must be called under the mutex
+func (store *webhooksStore) save() error {
+	content := &webhooksStoreContent{
+		Subscriptions: store.subscriptions,
+		LastNonces:    store.lastNonces,
+	}
+
+	buff, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	temporaryPath := store.path + ".tmp"
+	err = os.WriteFile(temporaryPath, buff, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(temporaryPath, store.path)
+}
+
+// Add persists a new subscription
+func (store *webhooksStore) Add(subscription *data.WebhookSubscription) error {
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	store.subscriptions = append(store.subscriptions, subscription)
+	err := store.save()
+	if err != nil {
+		store.subscriptions = store.subscriptions[:len(store.subscriptions)-1]
+	}
+
+	return err
+}
+
+// Remove deletes the subscription with the provided ID, along with the nonce of its latest notified hyperblock
+func (store *webhooksStore) Remove(id string) error {
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	for i, subscription := range store.subscriptions {
+		if subscription.ID != id {
+			continue
+		}
+
+		previousSubscriptions := store.subscriptions
+		store.subscriptions = make([]*data.WebhookSubscription, 0, len(previousSubscriptions)-1)
+		store.subscriptions = append(store.subscriptions, previousSubscriptions[:i]...)
+		store.subscriptions = append(store.subscriptions, previousSubscriptions[i+1:]...)
+
+		lastNonce, hasLastNonce := store.lastNonces[id]
+		delete(store.lastNonces, id)
+
+		err := store.save()
+		if err != nil {
+			store.subscriptions = previousSubscriptions
+			if hasLastNonce {
+				store.lastNonces[id] = lastNonce
+			}
+		}
+
+		return err
+	}
+
+	return ErrWebhookSubscriptionNotFound
+}
+
+// GetAll returns all the subscriptions, in registration order
+func (store *webhooksStore) GetAll() []*data.WebhookSubscription {
+	store.mut.RLock()
+	defer store.mut.RUnlock()
+
+	subscriptions := make([]*data.WebhookSubscription, len(store.subscriptions))
+	copy(subscriptions, store.subscriptions)
+
+	return subscriptions
+}
+
+// GetLastNonce returns the nonce of the latest hyperblock notified to the provided subscription, if any
+func (store *webhooksStore) GetLastNonce(id string) core.OptionalUint64 {
+	store.mut.RLock()
+	defer store.mut.RUnlock()
+
+	lastNonce, found := store.lastNonces[id]
+
+	return core.OptionalUint64{Value: lastNonce, HasValue: found}
+}
+
+// SetLastNonce persists the nonce of the latest hyperblock notified to the provided subscription. It is ignored if the
+// subscription has been removed in the meantime
+func (store *webhooksStore) SetLastNonce(id string, nonce uint64) error {
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	if !store.hasSubscription(id) {
+		return nil
+	}
+
+	previousNonce, hadPreviousNonce := store.lastNonces[id]
+	store.lastNonces[id] = nonce
+
+	err := store.save()
+	if err != nil {
+		if hadPreviousNonce {
+			store.lastNonces[id] = previousNonce
+		} else {
+			delete(store.lastNonces, id)
+		}
+	}
+
+	return err
+}
+
+func (store *webhooksStore) hasSubscription(id string) bool {
+	for _, subscription := range store.subscriptions {
+		if subscription.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (store *webhooksStore) IsInterfaceNil() bool {
+	return store == nil
+}
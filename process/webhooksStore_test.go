@@ -0,0 +1,76 @@
+package process_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhooksStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := process.NewWebhooksStore("")
+		require.True(t, check.IfNil(store))
+		require.Equal(t, process.ErrInvalidWebhooksStorePath, err)
+	})
+	t.Run("corrupted file should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "store.json")
+		require.Nil(t, os.WriteFile(path, []byte("not json"), 0600))
+
+		store, err := process.NewWebhooksStore(path)
+		require.True(t, check.IfNil(store))
+		require.NotNil(t, err)
+	})
+	t.Run("missing file should start empty", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := process.NewWebhooksStore(filepath.Join(t.TempDir(), "webhooks", "store.json"))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(store))
+		require.Empty(t, store.GetAll())
+		require.False(t, store.GetLastNonce("a").HasValue)
+	})
+}
+
+func TestWebhooksStore_ShouldPersistAcrossRestarts(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "store.json")
+	store, _ := process.NewWebhooksStore(path)
+
+	require.Nil(t, store.Add(&data.WebhookSubscription{ID: "a", Owner: "alice", URL: "http://localhost/a"}))
+	require.Nil(t, store.Add(&data.WebhookSubscription{ID: "b", Owner: "bob", URL: "http://localhost/b"}))
+	require.Nil(t, store.Add(&data.WebhookSubscription{ID: "c", Owner: "alice", URL: "http://localhost/c"}))
+	require.Nil(t, store.Remove("b"))
+	require.Equal(t, process.ErrWebhookSubscriptionNotFound, store.Remove("b"))
+	require.Nil(t, store.SetLastNonce("a", 37))
+	require.Nil(t, store.SetLastNonce("c", 36))
+	require.Nil(t, store.SetLastNonce("b", 35))
+
+	reopenedStore, err := process.NewWebhooksStore(path)
+	require.Nil(t, err)
+
+	subscriptions := reopenedStore.GetAll()
+	require.Len(t, subscriptions, 2)
+	require.Equal(t, "a", subscriptions[0].ID)
+	require.Equal(t, "c", subscriptions[1].ID)
+	require.Equal(t, "alice", subscriptions[1].Owner)
+
+	require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, reopenedStore.GetLastNonce("a"))
+	require.Equal(t, core.OptionalUint64{Value: 36, HasValue: true}, reopenedStore.GetLastNonce("c"))
+	require.False(t, reopenedStore.GetLastNonce("b").HasValue)
+
+	require.Nil(t, reopenedStore.Remove("a"))
+	require.False(t, reopenedStore.GetLastNonce("a").HasValue)
+}
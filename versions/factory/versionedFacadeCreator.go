@@ -29,6 +29,9 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	UsernameProcessor            facade.UsernameProcessor
+	HyperblocksFollower          facade.HyperblocksFollower
+	WebhooksProcessor            facade.WebhooksProcessor
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -111,6 +114,9 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		UsernameProcessor:            facadeArgs.UsernameProcessor,
+		HyperblocksFollower:          facadeArgs.HyperblocksFollower,
+		WebhooksProcessor:            facadeArgs.WebhooksProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -208,5 +214,8 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
 		args.AboutInfoProcessor,
+		args.UsernameProcessor,
+		args.HyperblocksFollower,
+		args.WebhooksProcessor,
 	)
 }